@@ -0,0 +1,150 @@
+package bitbucket
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	accessTokenKindRepository = "repository"
+	accessTokenKindProject    = "project"
+	accessTokenKindWorkspace  = "workspace"
+)
+
+type accessTokenScopeContextKey struct{}
+
+// accessTokenScope describes what a repository, project or workspace access token was issued for.
+type accessTokenScope struct {
+	Kind       string
+	Workspace  string
+	Project    string
+	Repository string
+}
+
+// authAttributes are the mutually exclusive authentication settings of the provider.
+var authAttributes = []string{
+	"username",
+	"password",
+	"oauth_client_id",
+	"oauth_client_secret",
+	"oauth_token",
+	"api_token",
+	"repository_access_token",
+	"project_access_token",
+	"workspace_access_token",
+}
+
+// conflictingAuth returns every authentication setting except the given ones.
+func conflictingAuth(keys ...string) []string {
+	var conflicts []string
+
+	for _, attr := range authAttributes {
+		conflict := true
+		for _, key := range keys {
+			if attr == key {
+				conflict = false
+				break
+			}
+		}
+
+		if conflict {
+			conflicts = append(conflicts, attr)
+		}
+	}
+
+	return conflicts
+}
+
+// expandAuthBlock returns the settings of a single authentication block, falling back to
+// the environment variables of its attributes when useEnv is set and the block isn't configured.
+func expandAuthBlock(d *schema.ResourceData, name string, envVars map[string]string, useEnv bool) map[string]string {
+	settings := map[string]string{}
+
+	if v, ok := d.GetOk(name); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		for key, value := range v.([]interface{})[0].(map[string]interface{}) {
+			settings[key] = value.(string)
+		}
+		return settings
+	}
+
+	if !useEnv {
+		return settings
+	}
+
+	for key, env := range envVars {
+		if value := os.Getenv(env); value != "" {
+			settings[key] = value
+		}
+	}
+
+	return settings
+}
+
+// covers reports whether a request to the given API path falls within the scope of the token.
+// Paths that don't name a workspace are assumed to be covered.
+func (s *accessTokenScope) covers(path string) bool {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+
+	for i, part := range parts {
+		switch part {
+		case "repositories":
+			if !s.matches(s.Workspace, parts, i+1) {
+				return false
+			}
+
+			if s.Kind == accessTokenKindRepository {
+				return s.matches(s.Repository, parts, i+2)
+			}
+
+			return true
+		case "workspaces":
+			if !s.matches(s.Workspace, parts, i+1) {
+				return false
+			}
+
+			switch s.Kind {
+			case accessTokenKindRepository:
+				return false
+			case accessTokenKindProject:
+				return len(parts) > i+2 && parts[i+2] == "projects" && s.matches(s.Project, parts, i+3)
+			}
+
+			return true
+		}
+	}
+
+	return true
+}
+
+// matches compares a path segment with an expected value, when both are known.
+func (s *accessTokenScope) matches(expected string, parts []string, index int) bool {
+	if expected == "" || len(parts) <= index {
+		return true
+	}
+
+	return strings.EqualFold(strings.Trim(parts[index], "{}"), strings.Trim(expected, "{}"))
+}
+
+func (s *accessTokenScope) String() string {
+	switch s.Kind {
+	case accessTokenKindRepository:
+		return fmt.Sprintf("repository %s/%s", s.Workspace, s.Repository)
+	case accessTokenKindProject:
+		return fmt.Sprintf("project %s/%s", s.Workspace, s.Project)
+	}
+
+	return fmt.Sprintf("workspace %s", s.Workspace)
+}
+
+// scopeMismatchMessage explains a 401 or 403 caused by using an access token outside of its scope.
+func scopeMismatchMessage(scope *accessTokenScope, statusCode int, path string) string {
+	if scope == nil || (statusCode != http.StatusUnauthorized && statusCode != http.StatusForbidden) || scope.covers(path) {
+		return ""
+	}
+
+	return fmt.Sprintf("the provider is configured with a %s access token for %s, which can't be used for %s", scope.Kind, scope, path)
+}
@@ -0,0 +1,73 @@
+package bitbucket
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestAccessTokenScopeCovers(t *testing.T) {
+	repoScope := &accessTokenScope{Kind: accessTokenKindRepository, Workspace: "ws", Repository: "repo"}
+	projectScope := &accessTokenScope{Kind: accessTokenKindProject, Workspace: "ws", Project: "PROJ"}
+	workspaceScope := &accessTokenScope{Kind: accessTokenKindWorkspace, Workspace: "ws"}
+
+	cases := []struct {
+		scope    *accessTokenScope
+		path     string
+		expected bool
+	}{
+		{repoScope, "/2.0/repositories/ws/repo/hooks", true},
+		{repoScope, "/2.0/repositories/WS/repo", true},
+		{repoScope, "/2.0/repositories/ws/other/hooks", false},
+		{repoScope, "/2.0/repositories/other/repo", false},
+		{repoScope, "/2.0/workspaces/ws/projects/PROJ", false},
+		{repoScope, "/2.0/user", true},
+		{projectScope, "/2.0/workspaces/ws/projects/PROJ/default-reviewers", true},
+		{projectScope, "/2.0/workspaces/ws/projects/OTHER", false},
+		{projectScope, "/2.0/workspaces/ws/hooks", false},
+		{projectScope, "/2.0/repositories/ws/repo", true},
+		{workspaceScope, "/2.0/workspaces/ws/hooks", true},
+		{workspaceScope, "/2.0/workspaces/other/hooks", false},
+		{workspaceScope, "/2.0/repositories/other/repo", false},
+		{&accessTokenScope{Kind: accessTokenKindRepository}, "/2.0/repositories/ws/repo", true},
+	}
+
+	for _, tc := range cases {
+		if got := tc.scope.covers(tc.path); got != tc.expected {
+			t.Errorf("%s access token for %s covering %s: expected %t, got %t", tc.scope.Kind, tc.scope, tc.path, tc.expected, got)
+		}
+	}
+}
+
+func TestScopeMismatchMessage(t *testing.T) {
+	scope := &accessTokenScope{Kind: accessTokenKindRepository, Workspace: "ws", Repository: "repo"}
+
+	if msg := scopeMismatchMessage(scope, http.StatusForbidden, "/2.0/repositories/ws/other"); msg == "" {
+		t.Error("expected a message for a repository outside of the token scope")
+	}
+
+	if msg := scopeMismatchMessage(scope, http.StatusNotFound, "/2.0/repositories/ws/other"); msg != "" {
+		t.Errorf("expected no message for a 404, got %q", msg)
+	}
+
+	if msg := scopeMismatchMessage(scope, http.StatusForbidden, "/2.0/repositories/ws/repo"); msg != "" {
+		t.Errorf("expected no message for a repository inside of the token scope, got %q", msg)
+	}
+
+	if msg := scopeMismatchMessage(nil, http.StatusForbidden, "/2.0/repositories/ws/other"); msg != "" {
+		t.Errorf("expected no message without an access token, got %q", msg)
+	}
+}
+
+func TestConflictingAuth(t *testing.T) {
+	conflicts := conflictingAuth("username", "password")
+
+	for _, conflict := range conflicts {
+		if conflict == "username" || conflict == "password" {
+			t.Fatalf("expected %s not to conflict with itself", conflict)
+		}
+	}
+
+	if len(conflicts) != len(authAttributes)-2 {
+		t.Fatalf("expected %d conflicts, got %d: %v", len(authAttributes)-2, len(conflicts), conflicts)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"log"
 	"net/http"
+	"strings"
 
 	"golang.org/x/oauth2"
 )
@@ -26,7 +27,7 @@ func (e Error) Error() string {
 }
 
 const (
-	// BitbucketEndpoint is the fqdn used to talk to bitbucket
+	// BitbucketEndpoint is the default fqdn used to talk to bitbucket
 	BitbucketEndpoint string = "https://api.bitbucket.org/"
 )
 
@@ -38,11 +39,18 @@ type Client struct {
 	OAuthToken       *string
 	OAuthTokenSource oauth2.TokenSource
 	HTTPClient       *http.Client
+	// BaseURL overrides BitbucketEndpoint, e.g. to go through a proxy or to hit a fake server.
+	BaseURL string
+	// AccessTokenScope is set when OAuthToken is a repository, project or workspace access token.
+	AccessTokenScope *accessTokenScope
 }
 
 // Do Will just call the bitbucket api but also add auth to it and some extra headers
 func (c *Client) Do(method, endpoint string, payload *bytes.Buffer, contentType string) (*http.Response, error) {
-	absoluteendpoint := BitbucketEndpoint + endpoint
+	absoluteendpoint := endpoint
+	if !strings.HasPrefix(endpoint, "https://") && !strings.HasPrefix(endpoint, "http://") {
+		absoluteendpoint = c.baseURL() + endpoint
+	}
 	log.Printf("[DEBUG] Sending request to %s %s", method, absoluteendpoint)
 
 	var bodyreader io.Reader
@@ -86,6 +94,10 @@ func (c *Client) Do(method, endpoint string, payload *bytes.Buffer, contentType
 
 	resp, err := c.HTTPClient.Do(req)
 	log.Printf("[DEBUG] Resp: %v Err: %v", resp, err)
+	if err != nil {
+		return nil, err
+	}
+
 	if resp.StatusCode >= 400 || resp.StatusCode < 200 {
 		apiError := Error{
 			StatusCode: resp.StatusCode,
@@ -104,12 +116,102 @@ func (c *Client) Do(method, endpoint string, payload *bytes.Buffer, contentType
 			apiError.APIError.Message = string(body)
 		}
 
+		if msg := scopeMismatchMessage(c.AccessTokenScope, resp.StatusCode, req.URL.Path); msg != "" {
+			apiError.APIError.Message = fmt.Sprintf("%s (%s)", apiError.APIError.Message, msg)
+		}
+
 		return resp, error(apiError)
 
 	}
 	return resp, err
 }
 
+// baseURL returns the URL every endpoint is relative to, always ending with a slash.
+func (c *Client) baseURL() string {
+	if c.BaseURL == "" {
+		return BitbucketEndpoint
+	}
+
+	return strings.TrimSuffix(c.BaseURL, "/") + "/"
+}
+
+// PaginatedResponse is the envelope the Bitbucket 2.0 API wraps every list in.
+type PaginatedResponse struct {
+	Values  []json.RawMessage `json:"values"`
+	Page    int               `json:"page,omitempty"`
+	Size    int               `json:"size,omitempty"`
+	PageLen int               `json:"pagelen,omitempty"`
+	Next    string            `json:"next,omitempty"`
+}
+
+// GetPaginated requests endpoint and follows the next link of every page until the last one,
+// calling fn with each raw value.
+func (c *Client) GetPaginated(endpoint string, fn func(value json.RawMessage) error) error {
+	for endpoint != "" {
+		res, err := c.Get(endpoint)
+		if err != nil {
+			return err
+		}
+
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[DEBUG] Paginated Response JSON: %v", string(body))
+
+		var page PaginatedResponse
+		if err := json.Unmarshal(body, &page); err != nil {
+			return err
+		}
+
+		for _, value := range page.Values {
+			if err := fn(value); err != nil {
+				return err
+			}
+		}
+
+		endpoint = c.relativeEndpoint(page.Next)
+	}
+
+	return nil
+}
+
+// relativeEndpoint strips the API host from a next link so the request keeps going through BaseURL.
+func (c *Client) relativeEndpoint(link string) string {
+	for _, base := range []string{c.baseURL(), BitbucketEndpoint} {
+		if strings.HasPrefix(link, base) {
+			return strings.TrimPrefix(link, base)
+		}
+	}
+
+	return link
+}
+
+// getAllPages decodes every value of a paginated endpoint into a slice of T.
+func getAllPages[T any](c *Client, endpoint string) ([]T, error) {
+	var values []T
+
+	err := c.GetPaginated(endpoint, func(value json.RawMessage) error {
+		var v T
+		if err := json.Unmarshal(value, &v); err != nil {
+			return err
+		}
+
+		values = append(values, v)
+		return nil
+	})
+
+	return values, err
+}
+
+// isNotFound reports whether err is a 404 returned by the Bitbucket API.
+func isNotFound(err error) bool {
+	apiErr, ok := err.(Error)
+	return ok && apiErr.StatusCode == http.StatusNotFound
+}
+
 // Get is just a helper method to do but with a GET verb
 func (c *Client) Get(endpoint string) (*http.Response, error) {
 	return c.Do("GET", endpoint, nil, "application/json")
@@ -0,0 +1,91 @@
+package bitbucket
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientDo_baseURL(t *testing.T) {
+	var gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	for _, baseURL := range []string{server.URL, server.URL + "/", server.URL + "/proxy/"} {
+		client := &Client{
+			HTTPClient: server.Client(),
+			BaseURL:    baseURL,
+		}
+
+		res, err := client.Get("2.0/user")
+		if err != nil {
+			t.Fatalf("unexpected error for base URL %q: %s", baseURL, err)
+		}
+		res.Body.Close()
+
+		expected := "/2.0/user"
+		if baseURL == server.URL+"/proxy/" {
+			expected = "/proxy/2.0/user"
+		}
+
+		if gotPath != expected {
+			t.Fatalf("expected request to %q for base URL %q, got %q", expected, baseURL, gotPath)
+		}
+	}
+}
+
+func TestClientGetPaginated(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "":
+			fmt.Fprintf(w, `{"values": [{"email": "a@example.com"}, {"email": "b@example.com"}], "next": "%s/2.0/user/emails?page=2"}`, server.URL)
+		case "2":
+			fmt.Fprint(w, `{"values": [{"email": "c@example.com"}], "next": "https://api.bitbucket.org/2.0/user/emails?page=3"}`)
+		case "3":
+			fmt.Fprint(w, `{"values": [{"email": "d@example.com"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	emails, err := getAllPages[UserEmail](client, "2.0/user/emails")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(emails) != 4 {
+		t.Fatalf("expected 4 emails, got %d: %#v", len(emails), emails)
+	}
+
+	if emails[3].Email != "d@example.com" {
+		t.Fatalf("expected last email to be d@example.com, got %s", emails[3].Email)
+	}
+}
+
+func TestClientGetPaginated_notFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	_, err := getAllPages[UserEmail](client, "2.0/user/emails")
+	if !isNotFound(err) {
+		t.Fatalf("expected not found error, got %#v", err)
+	}
+}
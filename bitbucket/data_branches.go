@@ -0,0 +1,109 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataBranches() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataReadBranches,
+		Description:        "Datasource to retrieve the branches of a repository",
+		Schema:             refsSchema("branches", "Branches of the repository"),
+	}
+}
+
+func dataReadBranches(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return readRefs(d, m, "branches")
+}
+
+// refsSchema returns the arguments of a data source listing the branches or tags of a
+// repository, which are returned in attribute.
+func refsSchema(attribute, description string) map[string]*schema.Schema {
+	s := commitSchema()
+	s["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Name of the ref",
+		Computed:    true,
+	}
+
+	return map[string]*schema.Schema{
+		"workspace": {
+			Type:         schema.TypeString,
+			Description:  "Workspace slug or {UUID}",
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"repository": {
+			Type:         schema.TypeString,
+			Description:  "Repository slug or {UUID}",
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"q": {
+			Type:        schema.TypeString,
+			Description: "Query to filter the results by, e.g. name ~ \"release/\"",
+			Optional:    true,
+		},
+		"sort": {
+			Type:        schema.TypeString,
+			Description: "Field to sort the results by, e.g. -target.date for the most recent first",
+			Optional:    true,
+		},
+		attribute: {
+			Type:        schema.TypeList,
+			Description: description,
+			Computed:    true,
+			Elem:        &schema.Resource{Schema: s},
+		},
+	}
+}
+
+// readRefs lists the branches or tags of a repository, depending on kind, following every page.
+func readRefs(d *schema.ResourceData, m interface{}, kind string) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	repo := d.Get("repository").(string)
+
+	params := url.Values{}
+	if q := d.Get("q").(string); q != "" {
+		params.Set("q", q)
+	}
+	if sort := d.Get("sort").(string); sort != "" {
+		params.Set("sort", sort)
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%s/%s/refs/%s", workspace, repo, kind)
+	if len(params) > 0 {
+		endpoint += "?" + params.Encode()
+	}
+
+	refs, err := getAllPages[Ref](&client, endpoint)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Refs Response Decoded: %d %s", len(refs), kind)
+
+	values := make([]interface{}, 0, len(refs))
+	for _, ref := range refs {
+		values = append(values, map[string]interface{}{
+			"name":   ref.Name,
+			"hash":   ref.Target.Hash,
+			"date":   ref.Target.Date,
+			"author": flattenCommitAuthor(ref.Target.Author),
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", workspace, repo, kind))
+	d.Set(kind, values)
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceBranches_basic(t *testing.T) {
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	repository := os.Getenv("BITBUCKET_REPO")
+	dataSourceName := "data.bitbucket_branches.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckRepo(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketDataBranchesConfig(workspace, repository),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "branches.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "branches.0.name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "branches.0.hash"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "branches.0.date"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDataSourceBranches_basic(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	dataSourceName := "data.bitbucket_branches.test"
+	repoPath := fmt.Sprintf("repositories/%s/tf-test-branches", fakeBitbucketTeam)
+
+	fake.Put(repoPath, nil)
+	first := fake.Commit(repoPath, "main", map[string]string{"README.md": "abc"})
+	head := fake.Commit(repoPath, "main", map[string]string{"README.md": "def"})
+	for i := 0; i < 11; i++ {
+		name := fmt.Sprintf("feature/%02d", i)
+		fake.Put(fmt.Sprintf("%s/refs/branches/%s", repoPath, name), map[string]interface{}{
+			"name":   name,
+			"target": map[string]interface{}{"hash": first},
+		})
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketDataBranchesConfig(fakeBitbucketTeam, "tf-test-branches"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "branches.#", "12"),
+					resource.TestCheckResourceAttr(dataSourceName, "branches.0.name", "main"),
+					resource.TestCheckResourceAttr(dataSourceName, "branches.0.hash", head),
+					resource.TestCheckResourceAttr(dataSourceName, "branches.0.date", "2024-01-01T00:02:00Z"),
+					resource.TestCheckResourceAttr(dataSourceName, "branches.0.author.0.raw", fakeBitbucketUsername),
+					resource.TestCheckResourceAttr(dataSourceName, "branches.1.name", "feature/00"),
+					resource.TestCheckResourceAttr(dataSourceName, "branches.1.hash", first),
+					resource.TestCheckResourceAttr(dataSourceName, "branches.11.name", "feature/10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBitbucketDataBranchesConfig(workspace, repository string) string {
+	return fmt.Sprintf(`
+data "bitbucket_branches" "test" {
+  workspace  = %[1]q
+  repository = %[2]q
+}
+`, workspace, repository)
+}
@@ -0,0 +1,170 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+
+	"github.com/DrFaust92/bitbucket-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Commit is a commit of a repository
+type Commit struct {
+	Hash    string        `json:"hash"`
+	Date    string        `json:"date,omitempty"`
+	Message string        `json:"message,omitempty"`
+	Author  *CommitAuthor `json:"author,omitempty"`
+	Parents []Commit      `json:"parents,omitempty"`
+}
+
+// CommitAuthor is the author of a commit, linked to a Bitbucket account when Bitbucket
+// recognizes the email address
+type CommitAuthor struct {
+	Raw  string             `json:"raw"`
+	User *bitbucket.Account `json:"user,omitempty"`
+}
+
+func dataCommit() *schema.Resource {
+	s := commitSchema()
+	s["workspace"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "Workspace slug or {UUID}",
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+	s["repository"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "Repository slug or {UUID}",
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+	s["ref"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "Commit hash, branch or tag name",
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+	s["parents"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Hashes of the parents of the commit",
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+	s["message"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Commit message",
+		Computed:    true,
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataReadCommit,
+		Description:        "Datasource to retrieve a commit of a repository",
+		Schema:             s,
+	}
+}
+
+// commitSchema returns the computed attributes of a commit.
+func commitSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"hash": {
+			Type:        schema.TypeString,
+			Description: "Commit hash",
+			Computed:    true,
+		},
+		"date": {
+			Type:        schema.TypeString,
+			Description: "Commit date",
+			Computed:    true,
+		},
+		"author": {
+			Type:        schema.TypeList,
+			Description: "Author of the commit",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"raw": {
+						Type:        schema.TypeString,
+						Description: "Author name and email as recorded in the commit",
+						Computed:    true,
+					},
+					"uuid": {
+						Type:        schema.TypeString,
+						Description: "Author UUID, if linked to a Bitbucket account",
+						Computed:    true,
+					},
+					"display_name": {
+						Type:        schema.TypeString,
+						Description: "Author display name, if linked to a Bitbucket account",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataReadCommit(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	repo := d.Get("repository").(string)
+	ref := d.Get("ref").(string)
+
+	res, err := client.Get(fmt.Sprintf("2.0/repositories/%s/%s/commit/%s", workspace, repo, url.PathEscape(ref)))
+	if isNotFound(err) {
+		return diag.Errorf("unable to find commit %q in %s/%s", ref, workspace, repo)
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, readerr := io.ReadAll(res.Body)
+	if readerr != nil {
+		return diag.FromErr(readerr)
+	}
+
+	log.Printf("[DEBUG] Commit Response JSON: %v", string(body))
+
+	var commit Commit
+	decodeerr := json.Unmarshal(body, &commit)
+	if decodeerr != nil {
+		return diag.FromErr(decodeerr)
+	}
+
+	parents := make([]interface{}, 0, len(commit.Parents))
+	for _, parent := range commit.Parents {
+		parents = append(parents, parent.Hash)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", workspace, repo, commit.Hash))
+	d.Set("hash", commit.Hash)
+	d.Set("date", commit.Date)
+	d.Set("message", commit.Message)
+	d.Set("author", flattenCommitAuthor(commit.Author))
+	d.Set("parents", parents)
+
+	return nil
+}
+
+func flattenCommitAuthor(author *CommitAuthor) []interface{} {
+	if author == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"raw": author.Raw,
+	}
+	if author.User != nil {
+		tfMap["uuid"] = author.User.Uuid
+		tfMap["display_name"] = author.User.DisplayName
+	}
+
+	return []interface{}{tfMap}
+}
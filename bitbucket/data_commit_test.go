@@ -0,0 +1,82 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceCommit_basic(t *testing.T) {
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	repository := os.Getenv("BITBUCKET_REPO")
+	commit := os.Getenv("BITBUCKET_COMMIT")
+	dataSourceName := "data.bitbucket_commit.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckRepo(t); testAccPreCheckFileCommit(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketDataCommitConfig(workspace, repository, commit),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "hash"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "message"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "date"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "author.0.raw"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDataSourceCommit_basic(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	dataSourceName := "data.bitbucket_commit.test"
+	repoPath := fmt.Sprintf("repositories/%s/tf-test-commit", fakeBitbucketTeam)
+
+	fake.Put(repoPath, nil)
+	first := fake.Commit(repoPath, "main", map[string]string{"README.md": "abc"})
+	fake.Put(repoPath+"/refs/tags/v1.0.0", map[string]interface{}{"name": "v1.0.0", "target": map[string]interface{}{"hash": first}})
+	head := fake.Commit(repoPath, "main", map[string]string{"README.md": "def"})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketDataCommitConfig(fakeBitbucketTeam, "tf-test-commit", "main"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "hash", head),
+					resource.TestCheckResourceAttr(dataSourceName, "message", "Commit from the fake"),
+					resource.TestCheckResourceAttr(dataSourceName, "date", "2024-01-01T00:02:00Z"),
+					resource.TestCheckResourceAttr(dataSourceName, "author.0.raw", fakeBitbucketUsername),
+					resource.TestCheckResourceAttr(dataSourceName, "parents.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "parents.0", first),
+				),
+			},
+			{
+				Config: testAccBitbucketDataCommitConfig(fakeBitbucketTeam, "tf-test-commit", "v1.0.0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "hash", first),
+					resource.TestCheckResourceAttr(dataSourceName, "parents.#", "0"),
+				),
+			},
+			{
+				Config:      testAccBitbucketDataCommitConfig(fakeBitbucketTeam, "tf-test-commit", "missing"),
+				ExpectError: regexp.MustCompile(`unable to find commit "missing"`),
+			},
+		},
+	})
+}
+
+func testAccBitbucketDataCommitConfig(workspace, repository, ref string) string {
+	return fmt.Sprintf(`
+data "bitbucket_commit" "test" {
+  workspace  = %[1]q
+  repository = %[2]q
+  ref        = %[3]q
+}
+`, workspace, repository, ref)
+}
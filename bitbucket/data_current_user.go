@@ -2,21 +2,12 @@ package bitbucket
 
 import (
 	"context"
-	"encoding/json"
-	"io"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-type PaginatedUserEmails struct {
-	Values []UserEmail `json:"values,omitempty"`
-	Page   int         `json:"page,omitempty"`
-	Size   int         `json:"size,omitempty"`
-	Next   string      `json:"next,omitempty"`
-}
-
 type UserEmail struct {
 	Email       string `json:"email"`
 	IsPrimary   bool   `json:"is_primary"`
@@ -76,32 +67,18 @@ func dataReadCurrentUser(ctx context.Context, d *schema.ResourceData, m interfac
 
 	log.Printf("[DEBUG] Current User: %#v", curUser)
 
-	curUserEmails, err := httpClient.Get("2.0/user/emails")
+	emails, err := getAllPages[UserEmail](&httpClient, "2.0/user/emails")
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	emailBody, readerr := io.ReadAll(curUserEmails.Body)
-	if readerr != nil {
-		return diag.FromErr(readerr)
-	}
-
-	log.Printf("[DEBUG] Current User Emails Response JSON: %v", string(emailBody))
-
-	var emails PaginatedUserEmails
-
-	decodeerr := json.Unmarshal(emailBody, &emails)
-	if decodeerr != nil {
-		return diag.FromErr(decodeerr)
-	}
-
 	log.Printf("[DEBUG] Current User Emails Response Decoded: %#v", emails)
 
 	d.SetId(curUser.Uuid)
 	d.Set("uuid", curUser.Uuid)
 	d.Set("username", curUser.Username)
 	d.Set("display_name", curUser.DisplayName)
-	d.Set("email", flattenUserEmails(emails.Values))
+	d.Set("email", flattenUserEmails(emails))
 
 	return nil
 }
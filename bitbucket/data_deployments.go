@@ -37,24 +37,21 @@ func dataDeployments() *schema.Resource {
 }
 
 func dataReadDeployments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(Clients).genClient
-	deployApi := c.ApiClient.DeploymentsApi
+	client := m.(Clients).httpClient
 
 	workspace := d.Get("workspace").(string)
 	repoId := d.Get("repository").(string)
 
-	deploymentsResp, res, err := deployApi.GetEnvironmentsForRepository(c.AuthContext, workspace, repoId, nil)
-	if err := handleClientError(res, err); err != nil {
+	deployments, err := getAllPages[Deployment](&client, fmt.Sprintf("2.0/repositories/%s/%s/environments", workspace, repoId))
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	deployments := deploymentsResp.Values
-
-	log.Printf("haha %#v", deployments)
+	log.Printf("[DEBUG] Deployments Response Decoded: %#v", deployments)
 
 	var uuids []string
 	for _, deployment := range deployments {
-		uuids = append(uuids, deployment.Uuid)
+		uuids = append(uuids, deployment.UUID)
 	}
 
 	var names []string
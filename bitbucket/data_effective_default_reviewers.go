@@ -0,0 +1,142 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/DrFaust92/bitbucket-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataEffectiveDefaultReviewers() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataReadEffectiveDefaultReviewers,
+		Description:        "Datasource to retrieve the default reviewers of a repository, including the ones inherited from its project",
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:         schema.TypeString,
+				Description:  "Workspace slug or {UUID}",
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"repository": {
+				Type:         schema.TypeString,
+				Description:  "Repository slug or {UUID}",
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"reviewers": {
+				Type:        schema.TypeList,
+				Description: "Effective default reviewers of the repository",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uuid": {
+							Type:        schema.TypeString,
+							Description: "Reviewer UUID",
+							Computed:    true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Reviewer display name",
+							Computed:    true,
+						},
+						"reviewer_type": {
+							Type:        schema.TypeString,
+							Description: "Where the reviewer is configured, either repository or project",
+							Computed:    true,
+						},
+						"origin": {
+							Type:        schema.TypeString,
+							Description: "Slug of the repository or key of the project the reviewer is configured on",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataReadEffectiveDefaultReviewers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	repo := d.Get("repository").(string)
+
+	reviewers, err := effectiveDefaultReviewers(&client, workspace, repo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Effective Default Reviewers Response Decoded: %d reviewers", len(reviewers))
+
+	project := ""
+	for _, reviewer := range reviewers {
+		if reviewer.ReviewerType == "project" {
+			project, err = repositoryProjectKey(&client, workspace, repo)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			break
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", workspace, repo))
+	d.Set("reviewers", flattenEffectiveDefaultReviewers(reviewers, repo, project))
+
+	return nil
+}
+
+func effectiveDefaultReviewers(client *Client, workspace, repo string) ([]DefaultReviewer, error) {
+	return getAllPages[DefaultReviewer](client, fmt.Sprintf("2.0/repositories/%s/%s/effective-default-reviewers", workspace, repo))
+}
+
+func repositoryProjectKey(client *Client, workspace, repo string) (string, error) {
+	res, err := client.Get(fmt.Sprintf("2.0/repositories/%s/%s", workspace, repo))
+	if err != nil {
+		return "", err
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+
+	var repository bitbucket.Repository
+	if err := json.Unmarshal(body, &repository); err != nil {
+		return "", err
+	}
+
+	if repository.Project == nil {
+		return "", nil
+	}
+
+	return repository.Project.Key, nil
+}
+
+func flattenEffectiveDefaultReviewers(reviewers []DefaultReviewer, repo, project string) []interface{} {
+	tfList := make([]interface{}, 0, len(reviewers))
+
+	for _, reviewer := range reviewers {
+		origin := repo
+		if reviewer.ReviewerType == "project" {
+			origin = project
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"uuid":          reviewer.User.Uuid,
+			"display_name":  reviewer.User.DisplayName,
+			"reviewer_type": reviewer.ReviewerType,
+			"origin":        origin,
+		})
+	}
+
+	return tfList
+}
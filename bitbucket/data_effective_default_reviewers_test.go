@@ -0,0 +1,131 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceEffectiveDefaultReviewers_basic(t *testing.T) {
+	dataSourceName := "data.bitbucket_effective_default_reviewers.test"
+	owner := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketDataEffectiveDefaultReviewersConfig(owner, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "reviewers.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "reviewers.0.uuid", "data.bitbucket_current_user.test", "uuid"),
+					resource.TestCheckResourceAttr(dataSourceName, "reviewers.0.reviewer_type", "project"),
+					resource.TestCheckResourceAttr(dataSourceName, "reviewers.0.origin", "TFEFFREV"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDataSourceEffectiveDefaultReviewers_basic(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	rName := acctest.RandomWithPrefix("tf-test")
+	dataSourceName := "data.bitbucket_effective_default_reviewers.test"
+	resourceName := "bitbucket_default_reviewers.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		CheckDestroy:      fake.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitBitbucketDataEffectiveDefaultReviewersConfig(fakeBitbucketTeam, rName),
+				Check: resource.ComposeTestCheckFunc(
+					// Reviewers inherited from the project are neither managed nor removed by
+					// the repository resource.
+					resource.TestCheckResourceAttr(resourceName, "reviewers.#", "1"),
+					fake.CheckExists(fmt.Sprintf("workspaces/%s/projects/TFEFFREV/default-reviewers/{p}", fakeBitbucketTeam), true),
+					resource.TestCheckResourceAttr(dataSourceName, "reviewers.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "reviewers.0.uuid", "{r}"),
+					resource.TestCheckResourceAttr(dataSourceName, "reviewers.0.reviewer_type", "repository"),
+					resource.TestCheckResourceAttr(dataSourceName, "reviewers.0.origin", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "reviewers.1.uuid", "{p}"),
+					resource.TestCheckResourceAttr(dataSourceName, "reviewers.1.reviewer_type", "project"),
+					resource.TestCheckResourceAttr(dataSourceName, "reviewers.1.origin", "TFEFFREV"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBitbucketDataEffectiveDefaultReviewersConfig(owner, rName string) string {
+	return fmt.Sprintf(`
+data "bitbucket_current_user" "test" {}
+
+resource "bitbucket_project" "test" {
+  owner = %[1]q
+  name  = %[2]q
+  key   = "TFEFFREV"
+}
+
+resource "bitbucket_project_default_reviewers" "test" {
+  workspace = %[1]q
+  project   = bitbucket_project.test.key
+  reviewers = [data.bitbucket_current_user.test.uuid]
+}
+
+resource "bitbucket_repository" "test" {
+  owner       = %[1]q
+  name        = %[2]q
+  project_key = bitbucket_project.test.key
+}
+
+data "bitbucket_effective_default_reviewers" "test" {
+  workspace  = %[1]q
+  repository = bitbucket_repository.test.name
+
+  depends_on = [bitbucket_project_default_reviewers.test]
+}
+`, owner, rName)
+}
+
+func testUnitBitbucketDataEffectiveDefaultReviewersConfig(owner, rName string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_project" "test" {
+  owner = %[1]q
+  name  = %[2]q
+  key   = "TFEFFREV"
+}
+
+resource "bitbucket_project_default_reviewers" "test" {
+  workspace = %[1]q
+  project   = bitbucket_project.test.key
+  reviewers = ["{p}"]
+}
+
+resource "bitbucket_repository" "test" {
+  owner       = %[1]q
+  name        = %[2]q
+  project_key = bitbucket_project.test.key
+}
+
+resource "bitbucket_default_reviewers" "test" {
+  owner      = %[1]q
+  repository = bitbucket_repository.test.name
+  reviewers  = ["{r}"]
+
+  depends_on = [bitbucket_project_default_reviewers.test]
+}
+
+data "bitbucket_effective_default_reviewers" "test" {
+  workspace  = %[1]q
+  repository = bitbucket_repository.test.name
+
+  depends_on = [bitbucket_default_reviewers.test]
+}
+`, owner, rName)
+}
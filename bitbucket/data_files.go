@@ -0,0 +1,179 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// SrcEntry is a file or directory of a directory listing
+type SrcEntry struct {
+	Path     string `json:"path"`
+	Type     string `json:"type"`
+	Size     int    `json:"size,omitempty"`
+	MimeType string `json:"mimetype,omitempty"`
+}
+
+func dataFiles() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataFilesRead,
+		Description:        "Datasource to list the files and directories of a directory",
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:         schema.TypeString,
+				Description:  "Workspace slug or UUID",
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"repo_slug": {
+				Type:         schema.TypeString,
+				Description:  "Repo slug or UUID",
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"commit": {
+				Type:        schema.TypeString,
+				Description: "Commit hash or branch name",
+				Required:    true,
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Description: "Path to the directory to list (starting from commit), the root of the repository by default",
+				Optional:    true,
+				Default:     "",
+			},
+			"max_depth": {
+				Type:         schema.TypeInt,
+				Description:  "How many levels of subdirectories to list, 1 lists only the directory itself",
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"include": {
+				Type:        schema.TypeList,
+				Description: "Only return entries matching one of these glob patterns. Patterns without a slash are matched against the name of the entry, others against its full path",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Description:  "Only return entries of this type",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"commit_file", "commit_directory"}, false),
+			},
+			"files": {
+				Type:        schema.TypeList,
+				Description: "Entries of the directory",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeString,
+							Description: "Path to object",
+							Computed:    true,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Description: "Type of object, commit_file or commit_directory",
+							Computed:    true,
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Description: "Size of object",
+							Computed:    true,
+						},
+						"mime_type": {
+							Type:        schema.TypeString,
+							Description: "Mimetype",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataFilesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	repoSlug := d.Get("repo_slug").(string)
+	commit := d.Get("commit").(string)
+	dir := strings.Trim(d.Get("path").(string), "/")
+	entryType := d.Get("type").(string)
+
+	var patterns []string
+	for _, v := range d.Get("include").([]interface{}) {
+		patterns = append(patterns, v.(string))
+	}
+
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return diag.Errorf("invalid include pattern %q: %s", pattern, err)
+		}
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%s/%s/src/%s/", workspace, repoSlug, commit)
+	if dir != "" {
+		endpoint += escapeSrcPath(dir) + "/"
+	}
+	endpoint += fmt.Sprintf("?max_depth=%d&pagelen=100", d.Get("max_depth").(int))
+
+	entries, err := getAllPages[SrcEntry](&client, endpoint)
+	if isNotFound(err) {
+		return diag.Errorf("unable to locate directory %q at %s in %s/%s", dir, commit, workspace, repoSlug)
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Files Response Decoded: %d entries", len(entries))
+
+	files := make([]interface{}, 0, len(entries))
+	for _, entry := range entries {
+		if entryType != "" && entry.Type != entryType {
+			continue
+		}
+
+		if len(patterns) > 0 && !matchSrcEntry(entry.Path, patterns) {
+			continue
+		}
+
+		files = append(files, map[string]interface{}{
+			"path":      entry.Path,
+			"type":      entry.Type,
+			"size":      entry.Size,
+			"mime_type": entry.MimeType,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", workspace, repoSlug, commit, dir))
+	d.Set("files", files)
+
+	return nil
+}
+
+// matchSrcEntry reports whether the path matches one of the glob patterns. Patterns without
+// a slash are matched against the last element of the path.
+func matchSrcEntry(p string, patterns []string) bool {
+	for _, pattern := range patterns {
+		name := p
+		if !strings.Contains(pattern, "/") {
+			name = path.Base(p)
+		}
+
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,143 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceFiles_basic(t *testing.T) {
+	dataSourceName := "data.bitbucket_files.test"
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	repository := os.Getenv("BITBUCKET_REPO")
+	commit := os.Getenv("BITBUCKET_COMMIT")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckRepo(t)
+			testAccPreCheckFileCommit(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketDataFilesConfig(workspace, repository, commit),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "repo_slug", repository),
+					resource.TestCheckResourceAttr(dataSourceName, "workspace", workspace),
+					resource.TestCheckResourceAttrSet(dataSourceName, "files.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "files.0.path"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "files.0.type"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDataSourceFiles_basic(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	repoPath := fmt.Sprintf("repositories/%s/tf-test-files", fakeBitbucketTeam)
+
+	files := map[string]string{
+		"README.md":               "# Modules",
+		"bitbucket-pipelines.yml": "image: hashicorp/terraform",
+	}
+	for i := 0; i < 6; i++ {
+		files[fmt.Sprintf("modules/m%02d/main.tf", i)] = "terraform {}"
+		files[fmt.Sprintf("modules/m%02d/variables.tf", i)] = ""
+	}
+
+	fake.Put(repoPath, nil)
+	fake.Commit(repoPath, "main", files)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitBitbucketDataFilesConfig(fakeBitbucketTeam, "tf-test-files"),
+				Check: resource.ComposeTestCheckFunc(
+					// The recursive listing spans several pages.
+					resource.TestCheckResourceAttr("data.bitbucket_files.tf", "files.#", "13"),
+					resource.TestCheckResourceAttr("data.bitbucket_files.tf", "files.0.path", "bitbucket-pipelines.yml"),
+					resource.TestCheckResourceAttr("data.bitbucket_files.tf", "files.0.type", "commit_file"),
+					resource.TestCheckResourceAttr("data.bitbucket_files.tf", "files.0.size", "26"),
+					resource.TestCheckResourceAttr("data.bitbucket_files.tf", "files.1.path", "modules/m00/main.tf"),
+					resource.TestCheckResourceAttr("data.bitbucket_files.tf", "files.12.path", "modules/m05/variables.tf"),
+					resource.TestCheckResourceAttr("data.bitbucket_files.modules", "files.#", "6"),
+					resource.TestCheckResourceAttr("data.bitbucket_files.modules", "files.0.path", "modules/m00"),
+					resource.TestCheckResourceAttr("data.bitbucket_files.modules", "files.0.type", "commit_directory"),
+				),
+			},
+			{
+				Config:      testAccBitbucketDataFilesMissingConfig(fakeBitbucketTeam, "tf-test-files"),
+				ExpectError: regexp.MustCompile(`unable to locate directory "missing"`),
+			},
+		},
+	})
+}
+
+func testAccBitbucketDataFilesConfig(workspace, repository, commit string) string {
+	return fmt.Sprintf(`
+data "bitbucket_files" "test" {
+  workspace = %[1]q
+  repo_slug = %[2]q
+  commit    = %[3]q
+}
+`, workspace, repository, commit)
+}
+
+func testUnitBitbucketDataFilesConfig(workspace, repository string) string {
+	return fmt.Sprintf(`
+data "bitbucket_files" "tf" {
+  workspace = %[1]q
+  repo_slug = %[2]q
+  commit    = "main"
+  max_depth = 3
+  type      = "commit_file"
+  include   = ["*.tf", "bitbucket-pipelines.yml"]
+}
+
+data "bitbucket_files" "modules" {
+  workspace = %[1]q
+  repo_slug = %[2]q
+  commit    = "main"
+  path      = "modules"
+  type      = "commit_directory"
+}
+`, workspace, repository)
+}
+
+func testAccBitbucketDataFilesMissingConfig(workspace, repository string) string {
+	return fmt.Sprintf(`
+data "bitbucket_files" "test" {
+  workspace = %[1]q
+  repo_slug = %[2]q
+  commit    = "main"
+  path      = "missing"
+}
+`, workspace, repository)
+}
+
+func TestMatchSrcEntry(t *testing.T) {
+	cases := []struct {
+		path     string
+		patterns []string
+		want     bool
+	}{
+		{"main.tf", []string{"*.tf"}, true},
+		{"modules/vpc/main.tf", []string{"*.tf"}, true},
+		{"modules/vpc/main.tf", []string{"modules/*.tf"}, false},
+		{"modules/vpc/main.tf", []string{"modules/*/*.tf"}, true},
+		{"ci/bitbucket-pipelines.yml", []string{"*.tf", "bitbucket-pipelines.yml"}, true},
+		{"README.md", []string{"*.tf"}, false},
+	}
+
+	for _, c := range cases {
+		if got := matchSrcEntry(c.path, c.patterns); got != c.want {
+			t.Errorf("matchSrcEntry(%q, %q) = %t, want %t", c.path, c.patterns, got, c.want)
+		}
+	}
+}
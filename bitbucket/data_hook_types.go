@@ -2,6 +2,7 @@ package bitbucket
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/DrFaust92/bitbucket-go-client"
@@ -47,17 +48,16 @@ func dataHookTypes() *schema.Resource {
 }
 
 func dataReadHookTypes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(Clients).genClient
-	webhooksApi := c.ApiClient.WebhooksApi
+	client := m.(Clients).httpClient
 
 	subjectType := d.Get("subject_type").(string)
-	hookTypes, res, err := webhooksApi.HookEventsSubjectTypeGet(c.AuthContext, subjectType, nil)
-	if err := handleClientError(res, err); err != nil {
+	hookTypes, err := getAllPages[bitbucket.HookEvent](&client, fmt.Sprintf("2.0/hook_events/%s", subjectType))
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(subjectType)
-	d.Set("hook_types", flattenHookTypes(hookTypes.Values))
+	d.Set("hook_types", flattenHookTypes(hookTypes))
 
 	return nil
 }
@@ -0,0 +1,119 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/DrFaust92/bitbucket-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataProjects() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataReadProjects,
+		Description:        "Datasource to retrieve the projects of a workspace",
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:         schema.TypeString,
+				Description:  "Workspace slug or {UUID}",
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"name_prefix": {
+				Type:        schema.TypeString,
+				Description: "Only return projects whose name starts with this prefix",
+				Optional:    true,
+			},
+			"key_prefix": {
+				Type:        schema.TypeString,
+				Description: "Only return projects whose key starts with this prefix",
+				Optional:    true,
+			},
+			"projects": {
+				Type:        schema.TypeList,
+				Description: "Projects of the workspace",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Description: "Project key",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Project name",
+							Computed:    true,
+						},
+						"uuid": {
+							Type:        schema.TypeString,
+							Description: "Project UUID",
+							Computed:    true,
+						},
+						"is_private": {
+							Type:        schema.TypeBool,
+							Description: "Project is private",
+							Computed:    true,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "Project description",
+							Computed:    true,
+						},
+						"has_publicly_visible_repos": {
+							Type:        schema.TypeBool,
+							Description: "Repositories are publicly visible",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataReadProjects(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	namePrefix := d.Get("name_prefix").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	projects, err := getAllPages[bitbucket.Project](&client, fmt.Sprintf("2.0/workspaces/%s/projects", workspace))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Projects Response Decoded: %d projects", len(projects))
+
+	d.SetId(workspace)
+	d.Set("projects", flattenProjects(projects, namePrefix, keyPrefix))
+
+	return nil
+}
+
+func flattenProjects(projects []bitbucket.Project, namePrefix, keyPrefix string) []interface{} {
+	tfList := make([]interface{}, 0, len(projects))
+
+	for _, project := range projects {
+		if !strings.HasPrefix(project.Name, namePrefix) || !strings.HasPrefix(project.Key, keyPrefix) {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"key":                        project.Key,
+			"name":                       project.Name,
+			"uuid":                       project.Uuid,
+			"is_private":                 project.IsPrivate,
+			"description":                project.Description,
+			"has_publicly_visible_repos": project.HasPubliclyVisibleRepos,
+		})
+	}
+
+	return tfList
+}
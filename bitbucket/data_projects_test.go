@@ -0,0 +1,104 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceProjects_basic(t *testing.T) {
+	dataSourceName := "data.bitbucket_projects.test"
+	testTeam := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketDataProjectsConfig(testTeam, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "projects.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "projects.0.key", "TFPROJECTS"),
+					resource.TestCheckResourceAttr(dataSourceName, "projects.0.name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "projects.0.description", "test"),
+					resource.TestCheckResourceAttr(dataSourceName, "projects.0.is_private", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "projects.0.has_publicly_visible_repos", "false"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "projects.0.uuid", "bitbucket_project.test", "uuid"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDataSourceProjects_basic(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	dataSourceName := "data.bitbucket_projects.test"
+
+	for i := 0; i < 12; i++ {
+		fake.Put(fmt.Sprintf("workspaces/%s/projects/PROJ%02d", fakeBitbucketTeam, i), map[string]interface{}{
+			"name":       fmt.Sprintf("project %02d", i),
+			"is_private": true,
+		})
+	}
+	fake.Put(fmt.Sprintf("workspaces/%s/projects/OTHER", fakeBitbucketTeam), map[string]interface{}{"name": "other"})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketDataProjectsFilterConfig(fakeBitbucketTeam, "", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "projects.#", "13"),
+				),
+			},
+			{
+				Config: testAccBitbucketDataProjectsFilterConfig(fakeBitbucketTeam, "PROJ1", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "projects.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "projects.0.key", "PROJ10"),
+					resource.TestCheckResourceAttr(dataSourceName, "projects.0.name", "project 10"),
+					resource.TestCheckResourceAttr(dataSourceName, "projects.0.is_private", "true"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "projects.0.uuid"),
+				),
+			},
+			{
+				Config: testAccBitbucketDataProjectsFilterConfig(fakeBitbucketTeam, "", "oth"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "projects.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "projects.0.key", "OTHER"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBitbucketDataProjectsConfig(team, rName string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_project" "test" {
+  owner       = %[1]q
+  name        = %[2]q
+  key         = "TFPROJECTS"
+  description = "test"
+}
+
+data "bitbucket_projects" "test" {
+  workspace   = %[1]q
+  name_prefix = bitbucket_project.test.name
+}
+`, team, rName)
+}
+
+func testAccBitbucketDataProjectsFilterConfig(team, keyPrefix, namePrefix string) string {
+	return fmt.Sprintf(`
+data "bitbucket_projects" "test" {
+  workspace   = %[1]q
+  key_prefix  = %[2]q
+  name_prefix = %[3]q
+}
+`, team, keyPrefix, namePrefix)
+}
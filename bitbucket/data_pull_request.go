@@ -0,0 +1,324 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/DrFaust92/bitbucket-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// PullRequest is a pull request as returned by the Bitbucket API
+type PullRequest struct {
+	ID                int                      `json:"id"`
+	Title             string                   `json:"title"`
+	Description       string                   `json:"description,omitempty"`
+	State             string                   `json:"state"`
+	Author            *bitbucket.Account       `json:"author,omitempty"`
+	Source            PullRequestEndpoint      `json:"source"`
+	Destination       PullRequestEndpoint      `json:"destination"`
+	MergeCommit       *PullRequestCommit       `json:"merge_commit,omitempty"`
+	CloseSourceBranch bool                     `json:"close_source_branch"`
+	Participants      []PullRequestParticipant `json:"participants,omitempty"`
+	Links             PullRequestLinks         `json:"links"`
+	CreatedOn         string                   `json:"created_on,omitempty"`
+	UpdatedOn         string                   `json:"updated_on,omitempty"`
+}
+
+// PullRequestEndpoint is the source or destination of a pull request
+type PullRequestEndpoint struct {
+	Branch struct {
+		Name string `json:"name"`
+	} `json:"branch"`
+	Commit *PullRequestCommit `json:"commit,omitempty"`
+}
+
+// PullRequestCommit is a commit referenced by a pull request
+type PullRequestCommit struct {
+	Hash string `json:"hash"`
+}
+
+// PullRequestParticipant is a user taking part in a pull request
+type PullRequestParticipant struct {
+	User     bitbucket.Account `json:"user"`
+	Role     string            `json:"role"`
+	Approved bool              `json:"approved"`
+	State    string            `json:"state,omitempty"`
+}
+
+// PullRequestLinks are the links of a pull request
+type PullRequestLinks struct {
+	Self *FileHref `json:"self,omitempty"`
+	Html *FileHref `json:"html,omitempty"`
+	Diff *FileHref `json:"diff,omitempty"`
+}
+
+func dataPullRequest() *schema.Resource {
+	s := pullRequestSchema()
+	delete(s, "id")
+	s["workspace"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "Workspace slug or {UUID}",
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+	s["repository"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "Repository slug or {UUID}",
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+	s["pull_request_id"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Description:  "Pull request ID",
+		Required:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataReadPullRequest,
+		Description:        "Datasource to retrieve a pull request of a repository",
+		Schema:             s,
+	}
+}
+
+// pullRequestSchema returns the computed attributes of a pull request.
+func pullRequestSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeInt,
+			Description: "Pull request ID",
+			Computed:    true,
+		},
+		"title": {
+			Type:        schema.TypeString,
+			Description: "Pull request title",
+			Computed:    true,
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Description: "Pull request description",
+			Computed:    true,
+		},
+		"state": {
+			Type:        schema.TypeString,
+			Description: "Pull request state, one of OPEN, MERGED, DECLINED or SUPERSEDED",
+			Computed:    true,
+		},
+		"author": {
+			Type:        schema.TypeList,
+			Description: "Author of the pull request",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"uuid": {
+						Type:        schema.TypeString,
+						Description: "Author UUID",
+						Computed:    true,
+					},
+					"display_name": {
+						Type:        schema.TypeString,
+						Description: "Author display name",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"source_branch": {
+			Type:        schema.TypeString,
+			Description: "Source branch",
+			Computed:    true,
+		},
+		"source_commit": {
+			Type:        schema.TypeString,
+			Description: "Commit hash of the source branch",
+			Computed:    true,
+		},
+		"destination_branch": {
+			Type:        schema.TypeString,
+			Description: "Destination branch",
+			Computed:    true,
+		},
+		"destination_commit": {
+			Type:        schema.TypeString,
+			Description: "Commit hash of the destination branch",
+			Computed:    true,
+		},
+		"merge_commit": {
+			Type:        schema.TypeString,
+			Description: "Hash of the merge commit, once the pull request is merged",
+			Computed:    true,
+		},
+		"close_source_branch": {
+			Type:        schema.TypeBool,
+			Description: "Source branch is deleted once merged",
+			Computed:    true,
+		},
+		"participants": {
+			Type:        schema.TypeList,
+			Description: "Participants of the pull request",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"uuid": {
+						Type:        schema.TypeString,
+						Description: "Participant UUID",
+						Computed:    true,
+					},
+					"display_name": {
+						Type:        schema.TypeString,
+						Description: "Participant display name",
+						Computed:    true,
+					},
+					"role": {
+						Type:        schema.TypeString,
+						Description: "Participant role, either PARTICIPANT or REVIEWER",
+						Computed:    true,
+					},
+					"approved": {
+						Type:        schema.TypeBool,
+						Description: "Participant approved the pull request",
+						Computed:    true,
+					},
+					"state": {
+						Type:        schema.TypeString,
+						Description: "Participant review state",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"links": {
+			Type:        schema.TypeList,
+			Description: "Links of the pull request",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"html": {
+						Type:        schema.TypeString,
+						Description: "Link to the pull request in the web UI",
+						Computed:    true,
+					},
+					"self": {
+						Type:        schema.TypeString,
+						Description: "Link to the pull request in the API",
+						Computed:    true,
+					},
+					"diff": {
+						Type:        schema.TypeString,
+						Description: "Link to the diff of the pull request",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"created_on": {
+			Type:        schema.TypeString,
+			Description: "Creation time",
+			Computed:    true,
+		},
+		"updated_on": {
+			Type:        schema.TypeString,
+			Description: "Last update time",
+			Computed:    true,
+		},
+	}
+}
+
+func dataReadPullRequest(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	repo := d.Get("repository").(string)
+	id := d.Get("pull_request_id").(int)
+
+	res, err := client.Get(fmt.Sprintf("2.0/repositories/%s/%s/pullrequests/%d", workspace, repo, id))
+	if isNotFound(err) {
+		return diag.Errorf("unable to locate pull request %d in repository %s/%s", id, workspace, repo)
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Pull Request Response JSON: %v", string(body))
+
+	var pr PullRequest
+	if err := json.Unmarshal(body, &pr); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%d", workspace, repo, pr.ID))
+	for k, v := range flattenPullRequest(&pr) {
+		if k == "id" {
+			continue
+		}
+		d.Set(k, v)
+	}
+
+	return nil
+}
+
+func flattenPullRequest(pr *PullRequest) map[string]interface{} {
+	author := []interface{}{}
+	if pr.Author != nil {
+		author = append(author, map[string]interface{}{
+			"uuid":         pr.Author.Uuid,
+			"display_name": pr.Author.DisplayName,
+		})
+	}
+
+	participants := make([]interface{}, 0, len(pr.Participants))
+	for _, p := range pr.Participants {
+		participants = append(participants, map[string]interface{}{
+			"uuid":         p.User.Uuid,
+			"display_name": p.User.DisplayName,
+			"role":         p.Role,
+			"approved":     p.Approved,
+			"state":        p.State,
+		})
+	}
+
+	links := map[string]interface{}{}
+	for k, href := range map[string]*FileHref{"html": pr.Links.Html, "self": pr.Links.Self, "diff": pr.Links.Diff} {
+		if href != nil {
+			links[k] = href.Href
+		}
+	}
+
+	return map[string]interface{}{
+		"id":                  pr.ID,
+		"title":               pr.Title,
+		"description":         pr.Description,
+		"state":               pr.State,
+		"author":              author,
+		"source_branch":       pr.Source.Branch.Name,
+		"source_commit":       pullRequestCommitHash(pr.Source.Commit),
+		"destination_branch":  pr.Destination.Branch.Name,
+		"destination_commit":  pullRequestCommitHash(pr.Destination.Commit),
+		"merge_commit":        pullRequestCommitHash(pr.MergeCommit),
+		"close_source_branch": pr.CloseSourceBranch,
+		"participants":        participants,
+		"links":               []interface{}{links},
+		"created_on":          pr.CreatedOn,
+		"updated_on":          pr.UpdatedOn,
+	}
+}
+
+func pullRequestCommitHash(commit *PullRequestCommit) string {
+	if commit == nil {
+		return ""
+	}
+
+	return commit.Hash
+}
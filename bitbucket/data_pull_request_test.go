@@ -0,0 +1,94 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePullRequest_basic(t *testing.T) {
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	repository := os.Getenv("BITBUCKET_REPO")
+	dataSourceName := "data.bitbucket_pull_request.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckRepo(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// Expects the repository to have at least one merged pull request.
+				Config: fmt.Sprintf(`
+data "bitbucket_pull_requests" "test" {
+  workspace  = %[1]q
+  repository = %[2]q
+  state      = ["MERGED"]
+}
+
+data "bitbucket_pull_request" "test" {
+  workspace       = %[1]q
+  repository      = %[2]q
+  pull_request_id = data.bitbucket_pull_requests.test.pull_requests[0].id
+}
+`, workspace, repository),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "state", "MERGED"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "title", "data.bitbucket_pull_requests.test", "pull_requests.0.title"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "merge_commit"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "links.0.html"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDataSourcePullRequest_basic(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	dataSourceName := "data.bitbucket_pull_request.test"
+	repoPath := fmt.Sprintf("repositories/%s/tf-test-pull-request", fakeBitbucketTeam)
+
+	fake.Put(repoPath, nil)
+	fake.Put(repoPath+"/pullrequests/7", map[string]interface{}{
+		"id":           7,
+		"title":        "Release 1.0",
+		"state":        "MERGED",
+		"source":       map[string]interface{}{"branch": map[string]interface{}{"name": "release/1.0"}},
+		"destination":  map[string]interface{}{"branch": map[string]interface{}{"name": "main"}},
+		"merge_commit": map[string]interface{}{"hash": "def456"},
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "bitbucket_pull_request" "test" {
+  workspace       = %[1]q
+  repository      = "tf-test-pull-request"
+  pull_request_id = 7
+}
+`, fakeBitbucketTeam),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", fmt.Sprintf("%s/tf-test-pull-request/7", fakeBitbucketTeam)),
+					resource.TestCheckResourceAttr(dataSourceName, "title", "Release 1.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "state", "MERGED"),
+					resource.TestCheckResourceAttr(dataSourceName, "merge_commit", "def456"),
+					resource.TestCheckResourceAttr(dataSourceName, "destination_branch", "main"),
+					resource.TestCheckResourceAttr(dataSourceName, "author.#", "0"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+data "bitbucket_pull_request" "test" {
+  workspace       = %[1]q
+  repository      = "tf-test-pull-request"
+  pull_request_id = 8
+}
+`, fakeBitbucketTeam),
+				ExpectError: regexp.MustCompile("unable to locate pull request 8"),
+			},
+		},
+	})
+}
@@ -0,0 +1,116 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataPullRequests() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataReadPullRequests,
+		Description:        "Datasource to retrieve the pull requests of a repository",
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:         schema.TypeString,
+				Description:  "Workspace slug or {UUID}",
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"repository": {
+				Type:         schema.TypeString,
+				Description:  "Repository slug or {UUID}",
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"state": {
+				Type:        schema.TypeSet,
+				Description: "Only return pull requests in these states. Bitbucket returns open pull requests only by default",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"OPEN", "MERGED", "DECLINED", "SUPERSEDED"}, false),
+				},
+			},
+			"source_branch": {
+				Type:        schema.TypeString,
+				Description: "Only return pull requests from this branch",
+				Optional:    true,
+			},
+			"destination_branch": {
+				Type:        schema.TypeString,
+				Description: "Only return pull requests into this branch",
+				Optional:    true,
+			},
+			"author": {
+				Type:        schema.TypeString,
+				Description: "Only return pull requests opened by the user with this UUID",
+				Optional:    true,
+			},
+			"pull_requests": {
+				Type:        schema.TypeList,
+				Description: "Pull requests of the repository",
+				Computed:    true,
+				Elem:        &schema.Resource{Schema: pullRequestSchema()},
+			},
+		},
+	}
+}
+
+func dataReadPullRequests(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	repo := d.Get("repository").(string)
+
+	params := url.Values{}
+	// Participants are left out of pull request lists unless asked for.
+	params.Set("fields", "+values.participants")
+	for _, state := range d.Get("state").(*schema.Set).List() {
+		params.Add("state", state.(string))
+	}
+	if q := pullRequestsQuery(d.Get("source_branch").(string), d.Get("destination_branch").(string), d.Get("author").(string)); q != "" {
+		params.Set("q", q)
+	}
+
+	prs, err := getAllPages[PullRequest](&client, fmt.Sprintf("2.0/repositories/%s/%s/pullrequests?%s", workspace, repo, params.Encode()))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Pull Requests Response Decoded: %d pull requests", len(prs))
+
+	pullRequests := make([]interface{}, 0, len(prs))
+	for _, pr := range prs {
+		pullRequests = append(pullRequests, flattenPullRequest(&pr))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", workspace, repo))
+	d.Set("pull_requests", pullRequests)
+
+	return nil
+}
+
+// pullRequestsQuery builds the Bitbucket query language filter for the given branches and author.
+func pullRequestsQuery(sourceBranch, destinationBranch, author string) string {
+	var clauses []string
+
+	if sourceBranch != "" {
+		clauses = append(clauses, fmt.Sprintf("source.branch.name = %q", sourceBranch))
+	}
+	if destinationBranch != "" {
+		clauses = append(clauses, fmt.Sprintf("destination.branch.name = %q", destinationBranch))
+	}
+	if author != "" {
+		clauses = append(clauses, fmt.Sprintf("author.uuid = %q", author))
+	}
+
+	return strings.Join(clauses, " AND ")
+}
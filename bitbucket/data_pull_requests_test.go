@@ -0,0 +1,99 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePullRequests_basic(t *testing.T) {
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	repository := os.Getenv("BITBUCKET_REPO")
+	dataSourceName := "data.bitbucket_pull_requests.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckRepo(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketDataPullRequestsConfig(workspace, repository),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "pull_requests.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDataSourcePullRequests_basic(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	dataSourceName := "data.bitbucket_pull_requests.test"
+	repoPath := fmt.Sprintf("repositories/%s/tf-test-pull-requests", fakeBitbucketTeam)
+
+	fake.Put(repoPath, nil)
+	fake.Put(repoPath+"/pullrequests/1", map[string]interface{}{
+		"id":          1,
+		"title":       "Release 1.0",
+		"state":       "OPEN",
+		"author":      map[string]interface{}{"uuid": "{author}", "display_name": "Author"},
+		"source":      map[string]interface{}{"branch": map[string]interface{}{"name": "feature"}, "commit": map[string]interface{}{"hash": "abc123"}},
+		"destination": map[string]interface{}{"branch": map[string]interface{}{"name": "release/1.0"}},
+		"participants": []interface{}{
+			map[string]interface{}{"user": map[string]interface{}{"uuid": "{reviewer}"}, "role": "REVIEWER", "approved": true, "state": "approved"},
+		},
+		"links": map[string]interface{}{"html": map[string]interface{}{"href": "https://bitbucket.org/fake/pull-requests/1"}},
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketDataPullRequestsConfig(fakeBitbucketTeam, "tf-test-pull-requests"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "pull_requests.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "pull_requests.0.id", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "pull_requests.0.title", "Release 1.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "pull_requests.0.state", "OPEN"),
+					resource.TestCheckResourceAttr(dataSourceName, "pull_requests.0.author.0.uuid", "{author}"),
+					resource.TestCheckResourceAttr(dataSourceName, "pull_requests.0.source_branch", "feature"),
+					resource.TestCheckResourceAttr(dataSourceName, "pull_requests.0.source_commit", "abc123"),
+					resource.TestCheckResourceAttr(dataSourceName, "pull_requests.0.destination_branch", "release/1.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "pull_requests.0.participants.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "pull_requests.0.participants.0.role", "REVIEWER"),
+					resource.TestCheckResourceAttr(dataSourceName, "pull_requests.0.participants.0.approved", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "pull_requests.0.links.0.html", "https://bitbucket.org/fake/pull-requests/1"),
+				),
+			},
+		},
+	})
+}
+
+func TestPullRequestsQuery(t *testing.T) {
+	cases := []struct {
+		source, destination, author string
+		want                        string
+	}{
+		{"", "", "", ""},
+		{"feature", "", "", `source.branch.name = "feature"`},
+		{"", "release/1.0", "{author}", `destination.branch.name = "release/1.0" AND author.uuid = "{author}"`},
+	}
+
+	for _, c := range cases {
+		if got := pullRequestsQuery(c.source, c.destination, c.author); got != c.want {
+			t.Errorf("pullRequestsQuery(%q, %q, %q) = %q, want %q", c.source, c.destination, c.author, got, c.want)
+		}
+	}
+}
+
+func testAccBitbucketDataPullRequestsConfig(workspace, repository string) string {
+	return fmt.Sprintf(`
+data "bitbucket_pull_requests" "test" {
+  workspace          = %[1]q
+  repository         = %[2]q
+  state              = ["OPEN"]
+  destination_branch = "release/1.0"
+}
+`, workspace, repository)
+}
@@ -0,0 +1,107 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/DrFaust92/bitbucket-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataRepositories() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataReadRepositories,
+		Description:        "Datasource to retrieve the repositories of a workspace",
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:         schema.TypeString,
+				Description:  "Workspace slug or UUID",
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"query": {
+				Type:        schema.TypeString,
+				Description: "Bitbucket query language filter, e.g. project.key = \"PROJ\"",
+				Optional:    true,
+			},
+			"sort": {
+				Type:        schema.TypeString,
+				Description: "Field to sort the repositories by, prefixed with - for descending order",
+				Optional:    true,
+			},
+			"repositories": {
+				Type:        schema.TypeList,
+				Description: "Repositories matching the query",
+				Computed:    true,
+				Elem:        dataRepositoriesElem(),
+			},
+		},
+	}
+}
+
+// dataRepositoriesElem returns the attributes of the bitbucket_repository data source as a
+// read only nested schema, along with the repository slug.
+func dataRepositoriesElem() *schema.Resource {
+	attrs := map[string]*schema.Schema{
+		"slug": {
+			Type:        schema.TypeString,
+			Description: "Repository slug",
+			Computed:    true,
+		},
+	}
+
+	for k, v := range dataRepository().Schema {
+		if k == "workspace" || k == "repo_slug" {
+			continue
+		}
+
+		attr := *v
+		attr.Optional = false
+		attr.Computed = true
+		attrs[k] = &attr
+	}
+
+	return &schema.Resource{Schema: attrs}
+}
+
+func dataReadRepositories(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+
+	params := url.Values{}
+	if v, ok := d.GetOk("query"); ok {
+		params.Set("q", v.(string))
+	}
+	if v, ok := d.GetOk("sort"); ok {
+		params.Set("sort", v.(string))
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%s", workspace)
+	if len(params) > 0 {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, params.Encode())
+	}
+
+	repos, err := getAllPages[bitbucket.Repository](&client, endpoint)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Repositories Response Decoded: %d repositories", len(repos))
+
+	repositories := make([]interface{}, 0, len(repos))
+	for _, repo := range repos {
+		attrs := flattenRepositoryAttributes(&repo)
+		attrs["slug"] = repo.Slug
+		repositories = append(repositories, attrs)
+	}
+
+	d.SetId(workspace)
+	d.Set("repositories", repositories)
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRepositories_basic(t *testing.T) {
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	rName := acctest.RandomWithPrefix("tf-test")
+	dataSourceName := "data.bitbucket_repositories.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketDataRepositoriesConfig(workspace, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "repositories.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "repositories.0.name", fmt.Sprintf("%s-a", rName)),
+					resource.TestCheckResourceAttr(dataSourceName, "repositories.0.slug", fmt.Sprintf("%s-a", rName)),
+					resource.TestCheckResourceAttr(dataSourceName, "repositories.0.full_name", fmt.Sprintf("%s/%s-a", workspace, rName)),
+					resource.TestCheckResourceAttr(dataSourceName, "repositories.0.scm", "git"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "repositories.0.uuid"),
+					resource.TestCheckResourceAttr(dataSourceName, "repositories.0.project.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "repositories.0.owner.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "repositories.1.name", fmt.Sprintf("%s-b", rName)),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDataSourceRepositories_basic(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	rName := acctest.RandomWithPrefix("tf-test")
+	dataSourceName := "data.bitbucket_repositories.test"
+
+	for i := 0; i < 12; i++ {
+		fake.Put(fmt.Sprintf("repositories/%s/%s-%02d", fakeBitbucketTeam, rName, i), map[string]interface{}{"scm": "git"})
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "bitbucket_repositories" "test" {
+  workspace = %[1]q
+}
+`, fakeBitbucketTeam),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", fakeBitbucketTeam),
+					resource.TestCheckResourceAttr(dataSourceName, "repositories.#", "12"),
+					resource.TestCheckResourceAttr(dataSourceName, "repositories.0.slug", fmt.Sprintf("%s-00", rName)),
+					resource.TestCheckResourceAttr(dataSourceName, "repositories.11.slug", fmt.Sprintf("%s-11", rName)),
+					resource.TestCheckResourceAttr(dataSourceName, "repositories.11.full_name", fmt.Sprintf("%s/%s-11", fakeBitbucketTeam, rName)),
+					resource.TestCheckResourceAttr(dataSourceName, "repositories.11.project.0.key", "FAKE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBitbucketDataRepositoriesConfig(workspace, rName string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "a" {
+  owner = %[1]q
+  name  = "%[2]s-a"
+}
+
+resource "bitbucket_repository" "b" {
+  owner = %[1]q
+  name  = "%[2]s-b"
+}
+
+data "bitbucket_repositories" "test" {
+  workspace = %[1]q
+  query     = "name ~ \"%[2]s\""
+  sort      = "name"
+
+  depends_on = [bitbucket_repository.a, bitbucket_repository.b]
+}
+`, workspace, rName)
+}
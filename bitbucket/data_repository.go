@@ -204,22 +204,34 @@ func flattenRepository(r *bitbucket.Repository, d *schema.ResourceData) {
 		return
 	}
 
-	d.Set("name", r.Name)
-	d.Set("full_name", r.FullName)
-	d.Set("language", r.Language)
-	d.Set("owner", flattenAccount(r.Owner))
-	d.Set("is_private", r.IsPrivate)
-	d.Set("description", r.Description)
-	d.Set("fork_policy", r.ForkPolicy)
-	d.Set("has_wiki", r.HasWiki)
-	d.Set("has_issues", r.HasIssues)
-	d.Set("scm", r.Scm)
-	d.Set("uuid", r.Uuid)
+	for k, v := range flattenRepositoryAttributes(r) {
+		d.Set(k, v)
+	}
+}
+
+// Flattens the repository info into the attributes shared by the repository data sources
+func flattenRepositoryAttributes(r *bitbucket.Repository) map[string]interface{} {
+	attrs := map[string]interface{}{
+		"name":        r.Name,
+		"full_name":   r.FullName,
+		"language":    r.Language,
+		"owner":       flattenAccount(r.Owner),
+		"is_private":  r.IsPrivate,
+		"description": r.Description,
+		"fork_policy": r.ForkPolicy,
+		"has_wiki":    r.HasWiki,
+		"has_issues":  r.HasIssues,
+		"scm":         r.Scm,
+		"uuid":        r.Uuid,
+		"project":     flattenProject(r.Project),
+		"link":        flattenLinks(r.Links),
+	}
+
 	if r.Mainbranch != nil {
-		d.Set("main_branch", r.Mainbranch.Name)
+		attrs["main_branch"] = r.Mainbranch.Name
 	}
-	d.Set("project", flattenProject(r.Project))
-	d.Set("link", flattenLinks(r.Links))
+
+	return attrs
 }
 
 func dataReadRepository(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -0,0 +1,20 @@
+package bitbucket
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataTags() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataReadTags,
+		Description:        "Datasource to retrieve the tags of a repository",
+		Schema:             refsSchema("tags", "Tags of the repository"),
+	}
+}
+
+func dataReadTags(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return readRefs(d, m, "tags")
+}
@@ -0,0 +1,69 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceTags_basic(t *testing.T) {
+	workspace := os.Getenv("BITBUCKET_TEAM")
+	repository := os.Getenv("BITBUCKET_REPO")
+	dataSourceName := "data.bitbucket_tags.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckRepo(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketDataTagsConfig(workspace, repository),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "tags.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDataSourceTags_basic(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	dataSourceName := "data.bitbucket_tags.test"
+	repoPath := fmt.Sprintf("repositories/%s/tf-test-tags", fakeBitbucketTeam)
+
+	fake.Put(repoPath, nil)
+	first := fake.Commit(repoPath, "main", map[string]string{"README.md": "abc"})
+	fake.Put(repoPath+"/refs/tags/v1.0.0", map[string]interface{}{"name": "v1.0.0", "target": map[string]interface{}{"hash": first}})
+	head := fake.Commit(repoPath, "main", map[string]string{"README.md": "def"})
+	fake.Put(repoPath+"/refs/tags/v1.1.0", map[string]interface{}{"name": "v1.1.0", "target": map[string]interface{}{"hash": "main"}})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketDataTagsConfig(fakeBitbucketTeam, "tf-test-tags"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tags.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.0.name", "v1.0.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.0.hash", first),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.0.date", "2024-01-01T00:01:00Z"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.1.name", "v1.1.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.1.hash", head),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.1.author.0.raw", fakeBitbucketUsername),
+				),
+			},
+		},
+	})
+}
+
+func testAccBitbucketDataTagsConfig(workspace, repository string) string {
+	return fmt.Sprintf(`
+data "bitbucket_tags" "test" {
+  workspace  = %[1]q
+  repository = %[2]q
+  q          = "name ~ \"v\""
+  sort       = "-target.date"
+}
+`, workspace, repository)
+}
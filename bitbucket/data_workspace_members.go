@@ -2,14 +2,18 @@ package bitbucket
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/DrFaust92/bitbucket-go-client"
-	"github.com/antihax/optional"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+type WorkspaceMembership struct {
+	User bitbucket.Account `json:"user"`
+}
+
 func dataWorkspaceMembers() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataReadWorkspaceMembers,
@@ -50,33 +54,21 @@ func dataWorkspaceMembers() *schema.Resource {
 }
 
 func dataReadWorkspaceMembers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(Clients).genClient
-
-	workspaceApi := c.ApiClient.WorkspacesApi
+	client := m.(Clients).httpClient
 
 	workspace := d.Get("workspace").(string)
 
+	memberships, err := getAllPages[WorkspaceMembership](&client, fmt.Sprintf("2.0/workspaces/%s/members", workspace))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	var members []string
 	var accounts []bitbucket.Account
-	options := bitbucket.WorkspacesApiWorkspacesWorkspaceMembersGetOpts{}
 
-	for {
-		flattenAccountsReq, res, err := workspaceApi.WorkspacesWorkspaceMembersGet(c.AuthContext, workspace, &options)
-		if err := handleClientError(res, err); err != nil {
-			return diag.FromErr(err)
-		}
-
-		for _, member := range flattenAccountsReq.Values {
-			members = append(members, member.User.Uuid)
-			accounts = append(accounts, *member.User)
-		}
-
-		if flattenAccountsReq.Next != "" {
-			nextPage := flattenAccountsReq.Page + 1
-			options.Page = optional.NewInt32(nextPage)
-		} else {
-			break
-		}
+	for _, member := range memberships {
+		members = append(members, member.User.Uuid)
+		accounts = append(accounts, member.User)
 	}
 
 	d.SetId(workspace)
@@ -31,6 +31,9 @@ func handleClientError(httpResponse *http.Response, err error) error {
 	clientHttpError, ok := err.(bitbucket.GenericSwaggerError)
 	if ok {
 		errorBody := extractErrorMessage(clientHttpError.Body())
+		if msg := requestScopeMismatch(httpResponse); msg != "" {
+			return fmt.Errorf("%s: %s (%s)", httpResponse.Status, errorBody, msg)
+		}
 		return fmt.Errorf("%s: %s", httpResponse.Status, errorBody)
 	}
 
@@ -57,3 +60,13 @@ func extractErrorMessage(body []byte) string {
 
 	return string(body[:])
 }
+
+// requestScopeMismatch explains a failed request made with an access token that doesn't cover it.
+func requestScopeMismatch(httpResponse *http.Response) string {
+	if httpResponse.Request == nil {
+		return ""
+	}
+
+	scope, _ := httpResponse.Request.Context().Value(accessTokenScopeContextKey{}).(*accessTokenScope)
+	return scopeMismatchMessage(scope, httpResponse.StatusCode, httpResponse.Request.URL.Path)
+}
@@ -0,0 +1,874 @@
+package bitbucket
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	fakeBitbucketUsername = "fake-user"
+	fakeBitbucketPassword = "fake-password"
+	fakeBitbucketTeam     = "fake-workspace"
+	fakeBitbucketPageLen  = 10
+)
+
+// fakeCollections are the path segments holding lists of objects, which are listed
+// on GET and get a generated identifier on POST.
+var fakeCollections = map[string]bool{
+	"branch-restrictions": true,
+	"branches":            true,
+	"build":               true,
+	"default-reviewers":   true,
+	"environments":        true,
+	"hooks":               true,
+	"projects":            true,
+	"pullrequests":        true,
+	"tags":                true,
+	"variables":           true,
+}
+
+// fakeSettings are singleton objects that always exist once their parent does and are
+// replaced on PUT. A nil value means the object is missing until it is first set.
+var fakeSettings = map[string]map[string]interface{}{
+	"override-settings": {
+		"default_merge_strategy": true,
+		"branching_model":        true,
+	},
+	"pipelines_config": nil,
+}
+
+// fakeBitbucket is an in-process stand-in for the Bitbucket Cloud 2.0 API, covering
+// enough of repositories, projects, hooks, pipeline variables, branch restrictions and
+// deployments for resources to be created, read, updated, imported and destroyed
+// without network access or real credentials.
+type fakeBitbucket struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	objects map[string]map[string]interface{}
+	order   []string
+	nextID  int
+}
+
+// newFakeBitbucket starts a fake Bitbucket API which is shut down when the test ends.
+func newFakeBitbucket(t *testing.T) *fakeBitbucket {
+	f := &fakeBitbucket{
+		objects: map[string]map[string]interface{}{},
+	}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+	t.Cleanup(f.Close)
+
+	return f
+}
+
+// testUnitProviderFactories returns provider factories pointed at the fake API, with
+// credentials the fake accepts. Provider settings from the environment are ignored.
+func testUnitProviderFactories(f *fakeBitbucket) map[string]func() (*schema.Provider, error) {
+	return map[string]func() (*schema.Provider, error){
+		"bitbucket": func() (*schema.Provider, error) {
+			p := Provider()
+
+			for _, attr := range authAttributes {
+				p.Schema[attr].DefaultFunc = nil
+			}
+
+			p.Schema["base_url"].DefaultFunc = func() (interface{}, error) { return f.URL, nil }
+			p.Schema["username"].DefaultFunc = func() (interface{}, error) { return fakeBitbucketUsername, nil }
+			p.Schema["password"].DefaultFunc = func() (interface{}, error) { return fakeBitbucketPassword, nil }
+			p.Schema["max_retries"].Default = 0
+
+			return p, nil
+		},
+	}
+}
+
+// CheckDestroy verifies nothing is left behind in the fake once all resources are destroyed.
+func (f *fakeBitbucket) CheckDestroy(s *terraform.State) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if len(f.order) > 0 {
+		return fmt.Errorf("objects still exist in the fake Bitbucket API: %s", strings.Join(f.order, ", "))
+	}
+
+	return nil
+}
+
+// CheckExists verifies whether an object is stored at the given API path, without the 2.0 prefix.
+func (f *fakeBitbucket) CheckExists(path string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if _, ok := f.Get(path); ok != exists {
+			return fmt.Errorf("expected %s to exist in the fake Bitbucket API: %t", path, exists)
+		}
+
+		return nil
+	}
+}
+
+// Get returns a copy of the object stored at the given API path, without the 2.0 prefix.
+func (f *fakeBitbucket) Get(path string) (map[string]interface{}, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	obj, ok := f.objects[strings.Trim(path, "/")]
+	if !ok {
+		return nil, false
+	}
+
+	return copyFakeObject(obj), true
+}
+
+// Put stores an object at the given API path, without the 2.0 prefix, e.g. to set up
+// data a resource expects to exist.
+func (f *fakeBitbucket) Put(path string, obj map[string]interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.store(strings.Trim(path, "/"), copyFakeObject(obj))
+}
+
+func (f *fakeBitbucket) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if username, password, ok := r.BasicAuth(); !ok || username != fakeBitbucketUsername || password != fakeBitbucketPassword {
+		writeFakeError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	if !strings.HasPrefix(r.URL.Path, "/2.0/") {
+		writeFakeError(w, http.StatusNotFound, fmt.Sprintf("%s not found", r.URL.Path))
+		return
+	}
+
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, "/2.0/"), "/")
+
+	// Files are committed with a multipart form rather than JSON.
+	if r.Method == http.MethodPost && strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		f.commit(w, r, path)
+		return
+	}
+
+	var body map[string]interface{}
+	if r.Method == http.MethodPost || r.Method == http.MethodPut {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil && err != io.EOF {
+			writeFakeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %s", err))
+			return
+		}
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		f.get(w, r, path)
+	case http.MethodPost:
+		f.post(w, path, body)
+	case http.MethodPut:
+		f.put(w, path, body)
+	case http.MethodDelete:
+		f.delete(w, path)
+	default:
+		writeFakeError(w, http.StatusMethodNotAllowed, fmt.Sprintf("%s isn't supported", r.Method))
+	}
+}
+
+func (f *fakeBitbucket) get(w http.ResponseWriter, r *http.Request, path string) {
+	if repoPath, ref, filePath, ok := splitFakeSrcPath(path); ok {
+		f.getSrc(w, r, repoPath, ref, filePath)
+		return
+	}
+
+	// Commits can be looked up by branch or tag name as well as by hash.
+	if parts := strings.Split(path, "/"); len(parts) == 5 && parts[0] == "repositories" && parts[3] == "commit" {
+		if hash := f.resolveRef(strings.Join(parts[:3], "/"), parts[4]); hash != "" {
+			path = fmt.Sprintf("%s/commit/%s", strings.Join(parts[:3], "/"), hash)
+		}
+	}
+
+	if obj, ok := f.objects[path]; ok {
+		writeFakeJSON(w, http.StatusOK, obj)
+		return
+	}
+
+	_, name := splitFakePath(path)
+
+	if !f.parentExists(path) {
+		writeFakeError(w, http.StatusNotFound, fmt.Sprintf("%s not found", path))
+		return
+	}
+
+	if defaults, ok := fakeSettings[name]; ok && defaults != nil {
+		writeFakeJSON(w, http.StatusOK, defaults)
+		return
+	}
+
+	// Repositories inherit the default reviewers of their project.
+	if parent, _ := splitFakePath(path); isFakeRepositoryPath(parent) {
+		switch name {
+		case "default-reviewers":
+			var values []interface{}
+			for _, reviewer := range f.effectiveDefaultReviewers(parent) {
+				values = append(values, reviewer.(map[string]interface{})["user"])
+			}
+			f.writePage(w, r, values)
+			return
+		case "effective-default-reviewers":
+			f.writePage(w, r, f.effectiveDefaultReviewers(parent))
+			return
+		}
+	}
+
+	if !fakeCollections[name] && !isFakeWorkspaceRepositoriesPath(path) {
+		writeFakeError(w, http.StatusNotFound, fmt.Sprintf("%s not found", path))
+		return
+	}
+
+	f.list(w, r, path)
+}
+
+// list writes a page of the objects directly under path.
+func (f *fakeBitbucket) list(w http.ResponseWriter, r *http.Request, path string) {
+	values := []interface{}{}
+	for _, key := range f.order {
+		if parent, _ := splitFakePath(key); parent == path || (isFakeRefPath(key) && strings.HasPrefix(key, path+"/")) {
+			values = append(values, f.objects[key])
+		}
+	}
+
+	f.writePage(w, r, values)
+}
+
+// commit handles a POST to the src endpoint of a repository, committing the files of the
+// multipart form on top of the head of the branch. Like Bitbucket, form fields named after a
+// path write the file and paths listed in the files field without content are deleted.
+func (f *fakeBitbucket) commit(w http.ResponseWriter, r *http.Request, path string) {
+	repoPath, name := splitFakePath(path)
+	if _, ok := f.objects[repoPath]; name != "src" || !ok || !isFakeRepositoryPath(repoPath) {
+		writeFakeError(w, http.StatusNotFound, fmt.Sprintf("%s not found", path))
+		return
+	}
+
+	if err := r.ParseMultipartForm(1 << 20); err != nil {
+		writeFakeError(w, http.StatusBadRequest, fmt.Sprintf("invalid form: %s", err))
+		return
+	}
+
+	branch := r.FormValue("branch")
+	if branch == "" {
+		branch = "main"
+	}
+
+	files := make(map[string]string)
+	var deleted []string
+
+	for filePath, headers := range r.MultipartForm.File {
+		file, err := headers[0].Open()
+		if err != nil {
+			writeFakeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		content, err := io.ReadAll(file)
+		file.Close()
+		if err != nil {
+			writeFakeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		files[filePath] = string(content)
+	}
+
+	for key, values := range r.MultipartForm.Value {
+		switch key {
+		case "message", "author", "branch", "parents":
+		case "files":
+			for _, filePath := range values {
+				if _, ok := r.MultipartForm.File[filePath]; !ok {
+					deleted = append(deleted, filePath)
+				}
+			}
+		default:
+			files[key] = values[0]
+		}
+	}
+
+	sha := f.applyCommit(repoPath, branch, r.FormValue("message"), r.FormValue("author"), files, deleted)
+
+	w.Header().Set("Location", fmt.Sprintf("%s/2.0/%s/commit/%s", f.URL, repoPath, sha))
+	w.WriteHeader(http.StatusCreated)
+}
+
+// Commit commits files to a branch of a repository, given by its API path without the 2.0
+// prefix, e.g. to simulate changes made outside of Terraform. It returns the commit hash.
+func (f *fakeBitbucket) Commit(repoPath, branch string, files map[string]string, deleted ...string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.applyCommit(strings.Trim(repoPath, "/"), branch, "Commit from the fake", fakeBitbucketUsername, files, deleted)
+}
+
+// applyCommit writes and deletes files on top of the head of the branch and moves the
+// branch to the new commit.
+func (f *fakeBitbucket) applyCommit(repoPath, branch, message, author string, changes map[string]string, deleted []string) string {
+	parent := f.branchHead(repoPath, branch)
+	files := f.commitFiles(repoPath, parent)
+
+	for filePath, content := range changes {
+		files[filePath] = content
+	}
+	for _, filePath := range deleted {
+		delete(files, filePath)
+	}
+
+	f.nextID++
+	sha := fmt.Sprintf("%040x", f.nextID)
+
+	commit := map[string]interface{}{
+		"type":    "commit",
+		"hash":    sha,
+		"message": message,
+		"date":    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(f.nextID) * time.Minute).Format(time.RFC3339),
+		"author":  map[string]interface{}{"type": "author", "raw": author},
+		"parents": []interface{}{},
+	}
+	if parent != "" {
+		commit["parents"] = []interface{}{map[string]interface{}{"type": "commit", "hash": parent}}
+	}
+	f.store(fmt.Sprintf("%s/commit/%s", repoPath, sha), commit)
+
+	paths := make([]string, 0, len(files))
+	for filePath := range files {
+		paths = append(paths, filePath)
+	}
+	sort.Strings(paths)
+
+	for _, filePath := range paths {
+		f.store(fmt.Sprintf("%s/src/%s/%s", repoPath, sha, filePath), map[string]interface{}{
+			"type":    "commit_file",
+			"path":    filePath,
+			"content": files[filePath],
+		})
+	}
+
+	f.store(fmt.Sprintf("%s/refs/branches/%s", repoPath, branch), map[string]interface{}{
+		"type":   "branch",
+		"name":   branch,
+		"target": map[string]interface{}{"type": "commit", "hash": sha},
+	})
+
+	return sha
+}
+
+// getSrc writes the raw content of a file at a commit or branch, or lists a directory.
+func (f *fakeBitbucket) getSrc(w http.ResponseWriter, r *http.Request, repoPath, ref, filePath string) {
+	if head := f.branchHead(repoPath, ref); head != "" {
+		ref = head
+	}
+
+	obj, ok := f.objects[fmt.Sprintf("%s/src/%s/%s", repoPath, ref, filePath)]
+	if !ok {
+		if entries := f.listSrc(r, repoPath, ref, filePath); len(entries) > 0 {
+			f.writePage(w, r, entries)
+			return
+		}
+
+		writeFakeError(w, http.StatusNotFound, fmt.Sprintf("%s not found at %s", filePath, ref))
+		return
+	}
+
+	content, _ := obj["content"].(string)
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+	_, _ = io.WriteString(w, content)
+}
+
+// listSrc returns the entries of a directory at a commit, descending into max_depth levels
+// of subdirectories like the src API does.
+func (f *fakeBitbucket) listSrc(r *http.Request, repoPath, sha, dir string) []interface{} {
+	depth := 1
+	if v, err := strconv.Atoi(r.URL.Query().Get("max_depth")); err == nil && v > 0 {
+		depth = v
+	}
+
+	prefix := ""
+	if dir != "" {
+		prefix = dir + "/"
+	}
+
+	var entries []interface{}
+	seen := make(map[string]bool)
+	files := f.commitFiles(repoPath, sha)
+
+	paths := make([]string, 0, len(files))
+	for filePath := range files {
+		paths = append(paths, filePath)
+	}
+	sort.Strings(paths)
+
+	for _, filePath := range paths {
+		rel, ok := strings.CutPrefix(filePath, prefix)
+		if !ok {
+			continue
+		}
+
+		segments := strings.Split(rel, "/")
+		for i := 1; i < len(segments) && i <= depth; i++ {
+			dirPath := prefix + strings.Join(segments[:i], "/")
+			if !seen[dirPath] {
+				seen[dirPath] = true
+				entries = append(entries, map[string]interface{}{"type": "commit_directory", "path": dirPath})
+			}
+		}
+
+		if len(segments) <= depth {
+			entries = append(entries, map[string]interface{}{
+				"type":     "commit_file",
+				"path":     filePath,
+				"size":     len(files[filePath]),
+				"mimetype": "text/plain",
+			})
+		}
+	}
+
+	return entries
+}
+
+// branchHead returns the hash of the commit a branch points at, if the branch exists.
+func (f *fakeBitbucket) branchHead(repoPath, branch string) string {
+	obj, ok := f.objects[fmt.Sprintf("%s/refs/branches/%s", repoPath, branch)]
+	if !ok {
+		return ""
+	}
+
+	target, _ := obj["target"].(map[string]interface{})
+	hash, _ := target["hash"].(string)
+
+	return hash
+}
+
+// resolveRef returns the hash of the commit a branch or tag points at, if either exists.
+func (f *fakeBitbucket) resolveRef(repoPath, ref string) string {
+	if head := f.branchHead(repoPath, ref); head != "" {
+		return head
+	}
+
+	obj, ok := f.objects[fmt.Sprintf("%s/refs/tags/%s", repoPath, ref)]
+	if !ok {
+		return ""
+	}
+
+	target, _ := obj["target"].(map[string]interface{})
+	hash, _ := target["hash"].(string)
+
+	return hash
+}
+
+// expandHash returns the full hash of the commit an abbreviated hash refers to, if any.
+func (f *fakeBitbucket) expandHash(repoPath, hash string) string {
+	if hash == "" {
+		return ""
+	}
+
+	prefix := fmt.Sprintf("%s/commit/%s", repoPath, hash)
+	for _, key := range f.order {
+		if strings.HasPrefix(key, prefix) {
+			return lastFakeSegment(key)
+		}
+	}
+
+	return ""
+}
+
+// commitFiles returns the content of every file at a commit, keyed by path.
+func (f *fakeBitbucket) commitFiles(repoPath, sha string) map[string]string {
+	files := make(map[string]string)
+	if sha == "" {
+		return files
+	}
+
+	prefix := fmt.Sprintf("%s/src/%s/", repoPath, sha)
+	for _, key := range f.order {
+		if filePath, ok := strings.CutPrefix(key, prefix); ok {
+			files[filePath], _ = f.objects[key]["content"].(string)
+		}
+	}
+
+	return files
+}
+
+// effectiveDefaultReviewers returns the default reviewers of a repository along with the
+// ones inherited from its project, the way the effective-default-reviewers API does. A
+// reviewer of both the repository and its project is listed once for each.
+func (f *fakeBitbucket) effectiveDefaultReviewers(repoPath string) []interface{} {
+	values := []interface{}{}
+
+	add := func(path, reviewerType string) {
+		for _, key := range f.order {
+			if parent, id := splitFakePath(key); parent == path {
+				values = append(values, map[string]interface{}{
+					"type":          "default_reviewer_and_type",
+					"reviewer_type": reviewerType,
+					"user":          map[string]interface{}{"type": "user", "uuid": id},
+				})
+			}
+		}
+	}
+
+	add(repoPath+"/default-reviewers", "repository")
+	if project, ok := f.objects[repoPath]["project"].(map[string]interface{}); ok {
+		parent, _ := splitFakePath(repoPath)
+		workspace := lastFakeSegment(parent)
+		add(fmt.Sprintf("workspaces/%s/projects/%s/default-reviewers", workspace, project["key"]), "project")
+	}
+
+	return values
+}
+
+// writePage writes a page of values, linking to the next page the same way Bitbucket does.
+func (f *fakeBitbucket) writePage(w http.ResponseWriter, r *http.Request, values []interface{}) {
+	if values == nil {
+		values = []interface{}{}
+	}
+
+	page := 1
+	if v, err := strconv.Atoi(r.URL.Query().Get("page")); err == nil && v > 0 {
+		page = v
+	}
+
+	start := (page - 1) * fakeBitbucketPageLen
+	end := start + fakeBitbucketPageLen
+	if start > len(values) {
+		start = len(values)
+	}
+	if end > len(values) {
+		end = len(values)
+	}
+
+	res := map[string]interface{}{
+		"values":  values[start:end],
+		"page":    page,
+		"pagelen": fakeBitbucketPageLen,
+		"size":    len(values),
+	}
+
+	if end < len(values) {
+		query := r.URL.Query()
+		query.Set("page", strconv.Itoa(page+1))
+		res["next"] = fmt.Sprintf("%s%s?%s", f.URL, r.URL.Path, query.Encode())
+	}
+
+	writeFakeJSON(w, http.StatusOK, res)
+}
+
+func (f *fakeBitbucket) post(w http.ResponseWriter, path string, body map[string]interface{}) {
+	if !f.parentExists(path) {
+		writeFakeError(w, http.StatusNotFound, fmt.Sprintf("%s not found", path))
+		return
+	}
+
+	parent, name := splitFakePath(path)
+
+	// Deployment environments are updated by posting a change to them.
+	if grandparent, _ := splitFakePath(parent); name == "changes" && lastFakeSegment(grandparent) == "environments" {
+		env, ok := f.objects[parent]
+		if !ok {
+			writeFakeError(w, http.StatusNotFound, fmt.Sprintf("%s not found", parent))
+			return
+		}
+
+		if change, ok := body["change"].(map[string]interface{}); ok {
+			for k, v := range change {
+				env[k] = v
+			}
+		}
+
+		writeFakeJSON(w, http.StatusAccepted, map[string]interface{}{})
+		return
+	}
+
+	itemPath := path
+	if fakeCollections[name] {
+		itemPath = fmt.Sprintf("%s/%s", path, f.newID(name, body))
+	} else if !isFakeRepositoryPath(path) {
+		writeFakeError(w, http.StatusNotFound, fmt.Sprintf("%s not found", path))
+		return
+	}
+
+	if _, ok := f.objects[itemPath]; ok {
+		writeFakeError(w, http.StatusBadRequest, fmt.Sprintf("%s already exists", itemPath))
+		return
+	}
+
+	f.store(itemPath, body)
+	writeFakeJSON(w, http.StatusCreated, f.objects[itemPath])
+}
+
+func (f *fakeBitbucket) put(w http.ResponseWriter, path string, body map[string]interface{}) {
+	obj, ok := f.objects[path]
+
+	parent, name := splitFakePath(path)
+	if _, setting := fakeSettings[name]; setting && f.parentExists(path) {
+		f.store(path, body)
+		writeFakeJSON(w, http.StatusOK, f.objects[path])
+		return
+	}
+
+	// Default reviewers are added by putting the user into the list.
+	if !ok && lastFakeSegment(parent) == "default-reviewers" && f.parentExists(path) {
+		f.store(path, body)
+		writeFakeJSON(w, http.StatusOK, f.objects[path])
+		return
+	}
+
+	if !ok {
+		writeFakeError(w, http.StatusNotFound, fmt.Sprintf("%s not found", path))
+		return
+	}
+
+	for k, v := range body {
+		obj[k] = v
+	}
+
+	f.store(path, obj)
+	writeFakeJSON(w, http.StatusOK, f.objects[path])
+}
+
+func (f *fakeBitbucket) delete(w http.ResponseWriter, path string) {
+	if _, ok := f.objects[path]; !ok {
+		writeFakeError(w, http.StatusNotFound, fmt.Sprintf("%s not found", path))
+		return
+	}
+
+	var remaining []string
+	for _, key := range f.order {
+		if key == path || strings.HasPrefix(key, path+"/") {
+			delete(f.objects, key)
+			continue
+		}
+		remaining = append(remaining, key)
+	}
+	f.order = remaining
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// store saves obj at path, filling in the attributes the real API computes.
+func (f *fakeBitbucket) store(path string, obj map[string]interface{}) {
+	if obj == nil {
+		obj = map[string]interface{}{}
+	}
+
+	parent, name := splitFakePath(path)
+
+	switch {
+	case isFakeRepositoryPath(path):
+		workspace := lastFakeSegment(parent)
+		if obj["name"] == nil || obj["name"] == "" {
+			obj["name"] = name
+		}
+		if _, ok := obj["project"].(map[string]interface{}); !ok {
+			obj["project"] = map[string]interface{}{"type": "project", "key": "FAKE"}
+		}
+		setFakeDefault(obj, "uuid", fmt.Sprintf("{%s}", uuid.NewV4()))
+		obj["type"] = "repository"
+		obj["slug"] = name
+		obj["full_name"] = fmt.Sprintf("%s/%s", workspace, name)
+		obj["links"] = map[string]interface{}{
+			"avatar": map[string]interface{}{"href": fmt.Sprintf("%s/%s/avatar", f.URL, obj["full_name"])},
+			"clone": []interface{}{
+				map[string]interface{}{"name": "https", "href": fmt.Sprintf("%s/%s.git", f.URL, obj["full_name"])},
+				map[string]interface{}{"name": "ssh", "href": fmt.Sprintf("git@fake.bitbucket.org:%s.git", obj["full_name"])},
+			},
+		}
+	case lastFakeSegment(parent) == "projects":
+		setFakeDefault(obj, "uuid", fmt.Sprintf("{%s}", uuid.NewV4()))
+		setFakeDefault(obj, "has_publicly_visible_repos", false)
+		obj["type"] = "project"
+		obj["key"] = name
+	case lastFakeSegment(parent) == "hooks":
+		secret, _ := obj["secret"].(string)
+		if _, ok := obj["secret"]; ok {
+			obj["secret_set"] = secret != ""
+			delete(obj, "secret")
+		}
+		setFakeDefault(obj, "secret_set", false)
+		setFakeDefault(obj, "history_enabled", false)
+		setFakeDefault(obj, "active", true)
+	case lastFakeSegment(parent) == "default-reviewers":
+		// Repositories list the reviewers' accounts, projects wrap them along with their type.
+		account := map[string]interface{}{"type": "user", "uuid": name}
+		if strings.HasPrefix(path, "workspaces/") {
+			obj = map[string]interface{}{"user": account, "reviewer_type": "project"}
+		} else {
+			obj = account
+		}
+	case isFakeRefPath(path):
+		// Refs may be created from a branch name, which resolves to the commit at its head.
+		parts := strings.Split(path, "/")
+		repoPath := strings.Join(parts[:3], "/")
+		target, _ := obj["target"].(map[string]interface{})
+		hash, _ := target["hash"].(string)
+		if head := f.branchHead(repoPath, hash); head != "" {
+			hash = head
+		} else if full := f.expandHash(repoPath, hash); full != "" {
+			hash = full
+		}
+		obj["target"] = map[string]interface{}{"type": "commit", "hash": hash}
+		if commit, ok := f.objects[fmt.Sprintf("%s/commit/%s", repoPath, hash)]; ok {
+			obj["target"] = copyFakeObject(commit)
+		}
+		if parts[4] == "tags" {
+			obj["type"] = "tag"
+			setFakeDefault(obj, "date", "2024-01-01T00:00:00+00:00")
+		} else {
+			obj["type"] = "branch"
+		}
+	case lastFakeSegment(parent) == "environments":
+		setFakeDefault(obj, "restrictions", map[string]interface{}{"admin_only": false})
+		setFakeDefault(obj, "environment_lock_enabled", true)
+		setFakeDefault(obj, "lock", map[string]interface{}{"type": "deployment_environment_lock_open", "name": "OPEN"})
+	case lastFakeSegment(parent) == "branch-restrictions":
+		// Bitbucket Cloud returns accounts by UUID, without a username.
+		if users, ok := obj["users"].([]interface{}); ok {
+			accounts := make([]interface{}, 0, len(users))
+			for _, user := range users {
+				account, _ := user.(map[string]interface{})
+				accounts = append(accounts, map[string]interface{}{"type": "user", "uuid": account["uuid"]})
+			}
+			obj["users"] = accounts
+		}
+	case lastFakeSegment(parent) == "variables":
+		if secured, _ := obj["secured"].(bool); secured {
+			delete(obj, "value")
+		}
+	}
+
+	if _, ok := f.objects[path]; !ok {
+		f.order = append(f.order, path)
+	}
+
+	f.objects[path] = obj
+}
+
+// newID returns the identifier of a new object in the given collection and sets it on body.
+func (f *fakeBitbucket) newID(collection string, body map[string]interface{}) string {
+	switch collection {
+	case "projects", "build":
+		key, _ := body["key"].(string)
+		return key
+	case "branches", "tags":
+		name, _ := body["name"].(string)
+		return name
+	case "branch-restrictions":
+		f.nextID++
+		body["id"] = f.nextID
+		return strconv.Itoa(f.nextID)
+	}
+
+	id := fmt.Sprintf("{%s}", uuid.NewV4())
+	body["uuid"] = id
+
+	return id
+}
+
+// parentExists reports whether the repository or project a path belongs to exists.
+func (f *fakeBitbucket) parentExists(path string) bool {
+	parts := strings.Split(path, "/")
+
+	switch {
+	case len(parts) > 3 && parts[0] == "repositories":
+		_, ok := f.objects[strings.Join(parts[:3], "/")]
+		return ok
+	case len(parts) > 4 && parts[0] == "workspaces" && parts[2] == "projects":
+		_, ok := f.objects[strings.Join(parts[:4], "/")]
+		return ok
+	}
+
+	return true
+}
+
+// splitFakeSrcPath splits a path to a file of a repository at a commit or branch. Like
+// Bitbucket, the first segment after src is always the ref, so a branch with a slash can't be
+// read through src and has to be resolved to its head commit first.
+func splitFakeSrcPath(path string) (string, string, string, bool) {
+	parts := strings.Split(path, "/")
+	if len(parts) < 5 || parts[0] != "repositories" || parts[3] != "src" {
+		return "", "", "", false
+	}
+
+	return strings.Join(parts[:3], "/"), parts[4], strings.Join(parts[5:], "/"), true
+}
+
+// isFakeRefPath reports whether path is a branch or tag of a repository, whose names may
+// contain slashes.
+func isFakeRefPath(path string) bool {
+	parts := strings.Split(path, "/")
+	return len(parts) > 5 && parts[0] == "repositories" && parts[3] == "refs" && (parts[4] == "branches" || parts[4] == "tags")
+}
+
+// isFakeWorkspaceRepositoriesPath reports whether path lists the repositories of a workspace.
+// Query and sort parameters are ignored by the fake.
+func isFakeWorkspaceRepositoriesPath(path string) bool {
+	parts := strings.Split(path, "/")
+	return len(parts) == 2 && parts[0] == "repositories"
+}
+
+func isFakeRepositoryPath(path string) bool {
+	parts := strings.Split(path, "/")
+	return len(parts) == 3 && parts[0] == "repositories"
+}
+
+func splitFakePath(path string) (string, string) {
+	i := strings.LastIndex(path, "/")
+	if i < 0 {
+		return "", path
+	}
+
+	return path[:i], path[i+1:]
+}
+
+func lastFakeSegment(path string) string {
+	_, name := splitFakePath(path)
+	return name
+}
+
+func setFakeDefault(obj map[string]interface{}, key string, value interface{}) {
+	if _, ok := obj[key]; !ok {
+		obj[key] = value
+	}
+}
+
+func copyFakeObject(obj map[string]interface{}) map[string]interface{} {
+	bytedata, _ := json.Marshal(obj)
+
+	var res map[string]interface{}
+	_ = json.Unmarshal(bytedata, &res)
+
+	return res
+}
+
+func writeFakeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeFakeError(w http.ResponseWriter, status int, message string) {
+	writeFakeJSON(w, status, map[string]interface{}{
+		"type": "error",
+		"error": map[string]interface{}{
+			"message": message,
+		},
+	})
+}
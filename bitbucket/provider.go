@@ -5,11 +5,15 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/DrFaust92/bitbucket-go-client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	oauth2bitbucket "golang.org/x/oauth2/bitbucket"
 	oauth2clientcreds "golang.org/x/oauth2/clientcredentials"
+	"golang.org/x/time/rate"
 )
 
 type ProviderConfig struct {
@@ -35,46 +39,181 @@ func Provider() *schema.Provider {
 				Optional:      true,
 				Type:          schema.TypeString,
 				DefaultFunc:   schema.EnvDefaultFunc("BITBUCKET_USERNAME", nil),
-				ConflictsWith: []string{"oauth_client_id", "oauth_client_secret", "oauth_token"},
+				ConflictsWith: conflictingAuth("username", "password"),
 				RequiredWith:  []string{"password"},
 			},
 			"password": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				DefaultFunc:   schema.EnvDefaultFunc("BITBUCKET_PASSWORD", nil),
-				ConflictsWith: []string{"oauth_client_id", "oauth_client_secret", "oauth_token"},
+				ConflictsWith: conflictingAuth("username", "password"),
 				RequiredWith:  []string{"username"},
 			},
 			"oauth_client_id": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				DefaultFunc:   schema.EnvDefaultFunc("BITBUCKET_OAUTH_CLIENT_ID", nil),
-				ConflictsWith: []string{"username", "password", "oauth_token"},
+				ConflictsWith: conflictingAuth("oauth_client_id", "oauth_client_secret"),
 				RequiredWith:  []string{"oauth_client_secret"},
 			},
 			"oauth_client_secret": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				DefaultFunc:   schema.EnvDefaultFunc("BITBUCKET_OAUTH_CLIENT_SECRET", nil),
-				ConflictsWith: []string{"username", "password", "oauth_token"},
+				ConflictsWith: conflictingAuth("oauth_client_id", "oauth_client_secret"),
 				RequiredWith:  []string{"oauth_client_id"},
 			},
 			"oauth_token": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				DefaultFunc:   schema.EnvDefaultFunc("BITBUCKET_OAUTH_TOKEN", nil),
-				ConflictsWith: []string{"username", "password", "oauth_client_id", "oauth_client_secret"},
+				ConflictsWith: conflictingAuth("oauth_token"),
+			},
+			"api_token": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: conflictingAuth("api_token"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email": {
+							Type:        schema.TypeString,
+							Required:    true,
+							DefaultFunc: schema.EnvDefaultFunc("BITBUCKET_API_TOKEN_EMAIL", nil),
+						},
+						"token": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							DefaultFunc: schema.EnvDefaultFunc("BITBUCKET_API_TOKEN", nil),
+						},
+					},
+				},
+			},
+			"repository_access_token": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: conflictingAuth("repository_access_token"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"token": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							DefaultFunc: schema.EnvDefaultFunc("BITBUCKET_REPOSITORY_ACCESS_TOKEN", nil),
+						},
+						"workspace": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							DefaultFunc: schema.EnvDefaultFunc("BITBUCKET_ACCESS_TOKEN_WORKSPACE", nil),
+						},
+						"repository": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							DefaultFunc: schema.EnvDefaultFunc("BITBUCKET_ACCESS_TOKEN_REPOSITORY", nil),
+						},
+					},
+				},
+			},
+			"project_access_token": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: conflictingAuth("project_access_token"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"token": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							DefaultFunc: schema.EnvDefaultFunc("BITBUCKET_PROJECT_ACCESS_TOKEN", nil),
+						},
+						"workspace": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							DefaultFunc: schema.EnvDefaultFunc("BITBUCKET_ACCESS_TOKEN_WORKSPACE", nil),
+						},
+						"project_key": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							DefaultFunc: schema.EnvDefaultFunc("BITBUCKET_ACCESS_TOKEN_PROJECT", nil),
+						},
+					},
+				},
+			},
+			"workspace_access_token": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: conflictingAuth("workspace_access_token"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"token": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							DefaultFunc: schema.EnvDefaultFunc("BITBUCKET_WORKSPACE_ACCESS_TOKEN", nil),
+						},
+						"workspace": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							DefaultFunc: schema.EnvDefaultFunc("BITBUCKET_ACCESS_TOKEN_WORKSPACE", nil),
+						},
+					},
+				},
+			},
+			"base_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("BITBUCKET_BASE_URL", BitbucketEndpoint),
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_min_backoff": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_max_backoff": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"burst": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
 			},
 		},
 		ConfigureFunc: providerConfigure,
 		ResourcesMap: map[string]*schema.Resource{
+			"bitbucket_branch":                      resourceBranch(),
+			"bitbucket_branch_protection":           resourceBranchProtection(),
 			"bitbucket_branch_restriction":          resourceBranchRestriction(),
 			"bitbucket_branching_model":             resourceBranchingModel(),
 			"bitbucket_commit_file":                 resourceCommitFile(),
+			"bitbucket_commit_files":                resourceCommitFiles(),
+			"bitbucket_commit_status":               resourceCommitStatus(),
+			"bitbucket_default_reviewer":            resourceDefaultReviewer(),
 			"bitbucket_default_reviewers":           resourceDefaultReviewers(),
 			"bitbucket_deploy_key":                  resourceDeployKey(),
 			"bitbucket_deployment":                  resourceDeployment(),
 			"bitbucket_deployment_variable":         resourceDeploymentVariable(),
+			"bitbucket_deployment_variables":        resourceDeploymentVariables(),
 			"bitbucket_forked_repository":           resourceForkedRepository(),
 			"bitbucket_gpg_key":                     resourceGpgKey(),
 			"bitbucket_group":                       resourceGroup(),
@@ -93,26 +232,36 @@ func Provider() *schema.Provider {
 			"bitbucket_repository_user_permission":  resourceRepositoryUserPermission(),
 			"bitbucket_repository_variable":         resourceRepositoryVariable(),
 			"bitbucket_ssh_key":                     resourceSshKey(),
+			"bitbucket_tag":                         resourceTag(),
 			"bitbucket_workspace_hook":              resourceWorkspaceHook(),
 			"bitbucket_workspace_variable":          resourceWorkspaceVariable(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"bitbucket_current_user":              dataCurrentUser(),
-			"bitbucket_deployment":                dataDeployment(),
-			"bitbucket_deployments":               dataDeployments(),
-			"bitbucket_file":                      dataFile(),
-			"bitbucket_group":                     dataGroup(),
-			"bitbucket_group_members":             dataGroupMembers(),
-			"bitbucket_groups":                    dataGroups(),
-			"bitbucket_hook_types":                dataHookTypes(),
-			"bitbucket_ip_ranges":                 dataIPRanges(),
-			"bitbucket_pipeline_oidc_config":      dataPipelineOidcConfig(),
-			"bitbucket_pipeline_oidc_config_keys": dataPipelineOidcConfigKeys(),
-			"bitbucket_project":                   dataProject(),
-			"bitbucket_repository":                dataRepository(),
-			"bitbucket_user":                      dataUser(),
-			"bitbucket_workspace":                 dataWorkspace(),
-			"bitbucket_workspace_members":         dataWorkspaceMembers(),
+			"bitbucket_branches":                    dataBranches(),
+			"bitbucket_commit":                      dataCommit(),
+			"bitbucket_current_user":                dataCurrentUser(),
+			"bitbucket_deployment":                  dataDeployment(),
+			"bitbucket_deployments":                 dataDeployments(),
+			"bitbucket_effective_default_reviewers": dataEffectiveDefaultReviewers(),
+			"bitbucket_file":                        dataFile(),
+			"bitbucket_files":                       dataFiles(),
+			"bitbucket_group":                       dataGroup(),
+			"bitbucket_group_members":               dataGroupMembers(),
+			"bitbucket_groups":                      dataGroups(),
+			"bitbucket_hook_types":                  dataHookTypes(),
+			"bitbucket_ip_ranges":                   dataIPRanges(),
+			"bitbucket_pipeline_oidc_config":        dataPipelineOidcConfig(),
+			"bitbucket_pipeline_oidc_config_keys":   dataPipelineOidcConfigKeys(),
+			"bitbucket_project":                     dataProject(),
+			"bitbucket_projects":                    dataProjects(),
+			"bitbucket_pull_request":                dataPullRequest(),
+			"bitbucket_pull_requests":               dataPullRequests(),
+			"bitbucket_repository":                  dataRepository(),
+			"bitbucket_repositories":                dataRepositories(),
+			"bitbucket_tags":                        dataTags(),
+			"bitbucket_user":                        dataUser(),
+			"bitbucket_workspace":                   dataWorkspace(),
+			"bitbucket_workspace_members":           dataWorkspaceMembers(),
 		},
 	}
 }
@@ -120,8 +269,29 @@ func Provider() *schema.Provider {
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	authCtx := context.Background()
 
+	baseURL := d.Get("base_url").(string)
+
+	var transport http.RoundTripper = http.DefaultTransport
+	if v, ok := d.GetOk("requests_per_second"); ok && v.(float64) > 0 {
+		log.Printf("[DEBUG] Limiting API requests to %v per second", v)
+		transport = &rateLimitTransport{
+			Transport: transport,
+			Limiter:   rate.NewLimiter(rate.Limit(v.(float64)), d.Get("burst").(int)),
+		}
+	}
+
+	httpClient := &http.Client{
+		Transport: &retryTransport{
+			Transport:  transport,
+			MaxRetries: d.Get("max_retries").(int),
+			MinBackoff: time.Duration(d.Get("retry_min_backoff").(int)) * time.Second,
+			MaxBackoff: time.Duration(d.Get("retry_max_backoff").(int)) * time.Second,
+		},
+	}
+
 	client := &Client{
-		HTTPClient: &http.Client{},
+		HTTPClient: httpClient,
+		BaseURL:    baseURL,
 	}
 
 	if username, ok := d.GetOk("username"); ok {
@@ -149,6 +319,85 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		authCtx = context.WithValue(authCtx, bitbucket.ContextAccessToken, token)
 	}
 
+	// The environment variables of the token blocks only apply when no other authentication is configured.
+	useEnv := true
+	for _, attr := range authAttributes {
+		if _, ok := d.GetOk(attr); ok {
+			useEnv = false
+		}
+	}
+
+	if apiToken := expandAuthBlock(d, "api_token", map[string]string{
+		"email": "BITBUCKET_API_TOKEN_EMAIL",
+		"token": "BITBUCKET_API_TOKEN",
+	}, useEnv); apiToken["token"] != "" {
+		if apiToken["email"] == "" {
+			return nil, fmt.Errorf("found API token, but the email of its Atlassian account was not specified")
+		}
+		log.Printf("[DEBUG] Using API Token Auth")
+
+		email := apiToken["email"]
+		token := apiToken["token"]
+
+		cred := bitbucket.BasicAuth{
+			UserName: email,
+			Password: token,
+		}
+		authCtx = context.WithValue(authCtx, bitbucket.ContextBasicAuth, cred)
+		client.Username = &email
+		client.Password = &token
+	}
+
+	accessTokens := []struct {
+		kind    string
+		block   string
+		envVars map[string]string
+	}{
+		{accessTokenKindRepository, "repository_access_token", map[string]string{
+			"token":      "BITBUCKET_REPOSITORY_ACCESS_TOKEN",
+			"workspace":  "BITBUCKET_ACCESS_TOKEN_WORKSPACE",
+			"repository": "BITBUCKET_ACCESS_TOKEN_REPOSITORY",
+		}},
+		{accessTokenKindProject, "project_access_token", map[string]string{
+			"token":       "BITBUCKET_PROJECT_ACCESS_TOKEN",
+			"workspace":   "BITBUCKET_ACCESS_TOKEN_WORKSPACE",
+			"project_key": "BITBUCKET_ACCESS_TOKEN_PROJECT",
+		}},
+		{accessTokenKindWorkspace, "workspace_access_token", map[string]string{
+			"token":     "BITBUCKET_WORKSPACE_ACCESS_TOKEN",
+			"workspace": "BITBUCKET_ACCESS_TOKEN_WORKSPACE",
+		}},
+	}
+
+	for _, accessToken := range accessTokens {
+		settings := expandAuthBlock(d, accessToken.block, accessToken.envVars, useEnv)
+		if settings["token"] == "" {
+			continue
+		}
+
+		if client.AccessTokenScope != nil {
+			return nil, fmt.Errorf("found both a %s and a %s access token, only one can be used", client.AccessTokenScope.Kind, accessToken.kind)
+		}
+
+		if client.Username != nil {
+			return nil, fmt.Errorf("found both basic auth credentials and a %s access token, only one can be used", accessToken.kind)
+		}
+		log.Printf("[DEBUG] Using %s Access Token Auth", accessToken.kind)
+
+		token := settings["token"]
+		scope := &accessTokenScope{
+			Kind:       accessToken.kind,
+			Workspace:  settings["workspace"],
+			Project:    settings["project_key"],
+			Repository: settings["repository"],
+		}
+
+		client.OAuthToken = &token
+		client.AccessTokenScope = scope
+		authCtx = context.WithValue(authCtx, bitbucket.ContextAccessToken, token)
+		authCtx = context.WithValue(authCtx, accessTokenScopeContextKey{}, scope)
+	}
+
 	if clientID, ok := d.GetOk("oauth_client_id"); ok {
 		clientSecret, ok := d.GetOk("oauth_client_secret")
 		if !ok {
@@ -168,6 +417,8 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 
 	conf := bitbucket.NewConfiguration()
+	conf.BasePath = strings.TrimSuffix(baseURL, "/") + "/2.0"
+	conf.HTTPClient = httpClient
 	apiClient := ProviderConfig{
 		ApiClient:   bitbucket.NewAPIClient(conf),
 		AuthContext: authCtx,
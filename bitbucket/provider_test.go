@@ -35,9 +35,15 @@ func testAccPreCheck(t *testing.T) {
 	oauth_token_v := os.Getenv("BITBUCKET_OAUTH_TOKEN")
 	oauth_client_v := os.Getenv("BITBUCKET_OAUTH_CLIENT_ID")
 	oauth_secret_v := os.Getenv("BITBUCKET_OAUTH_CLIENT_SECRET")
+	api_token_v := os.Getenv("BITBUCKET_API_TOKEN")
+	access_token_v := os.Getenv("BITBUCKET_REPOSITORY_ACCESS_TOKEN") + os.Getenv("BITBUCKET_PROJECT_ACCESS_TOKEN") + os.Getenv("BITBUCKET_WORKSPACE_ACCESS_TOKEN")
 
-	if user_v == "" && oauth_token_v == "" && oauth_client_v == "" {
-		t.Fatal("BITBUCKET_USERNAME or BITBUCKET_OAUTH_TOKEN or BITBUCKET_OAUTH_CLIENT_ID must be set for acceptance tests")
+	if user_v == "" && oauth_token_v == "" && oauth_client_v == "" && api_token_v == "" && access_token_v == "" {
+		t.Fatal("BITBUCKET_USERNAME, BITBUCKET_OAUTH_TOKEN, BITBUCKET_OAUTH_CLIENT_ID, BITBUCKET_API_TOKEN or an access token must be set for acceptance tests")
+	}
+
+	if api_token_v != "" && os.Getenv("BITBUCKET_API_TOKEN_EMAIL") == "" {
+		t.Fatal("BITBUCKET_API_TOKEN_EMAIL must be set if using BITBUCKET_API_TOKEN for acceptance tests")
 	}
 
 	if (pass_v == "" && user_v != "") || (pass_v != "" && (oauth_token_v != "" || oauth_secret_v != "")) {
@@ -0,0 +1,178 @@
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Ref is a branch or tag of a repository
+type Ref struct {
+	Name    string `json:"name"`
+	Type    string `json:"type,omitempty"`
+	Message string `json:"message,omitempty"`
+	Date    string `json:"date,omitempty"`
+	Target  Commit `json:"target"`
+}
+
+func resourceBranch() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBranchCreate,
+		ReadWithoutTimeout:   resourceBranchRead,
+		DeleteWithoutTimeout: resourceBranchDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"owner": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The commit hash or branch name the branch is created from",
+				// The source only matters when creating the branch, moving it would mean deleting
+				// the commits made to it since. Changes are suppressed once the branch exists, so
+				// ForceNew never replaces it; it is only set because the resource has no Update.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != ""
+				},
+			},
+			"hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The hash of the commit at the head of the branch",
+			},
+		},
+	}
+}
+
+func resourceBranchCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	owner := d.Get("owner").(string)
+	repo := d.Get("repository").(string)
+	name := d.Get("name").(string)
+
+	branch := &Ref{
+		Name:   name,
+		Target: Commit{Hash: d.Get("source").(string)},
+	}
+
+	if err := createRef(&client, owner, repo, "branches", branch); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", owner, repo, name))
+
+	return resourceBranchRead(ctx, d, m)
+}
+
+func resourceBranchRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	owner, repo, name, err := refId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	branch, err := readRef(&client, owner, repo, "branches", name)
+	if isNotFound(err) {
+		log.Printf("[WARN] Branch (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("owner", owner)
+	d.Set("repository", repo)
+	d.Set("name", branch.Name)
+	d.Set("hash", branch.Target.Hash)
+
+	return nil
+}
+
+func resourceBranchDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	owner, repo, name, err := refId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.Delete(fmt.Sprintf("2.0/repositories/%s/%s/refs/branches/%s", owner, repo, url.PathEscape(name)))
+
+	return diag.FromErr(err)
+}
+
+// createRef creates a branch or tag, depending on kind.
+func createRef(client *Client, owner, repo, kind string, ref *Ref) error {
+	payload, err := json.Marshal(ref)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Post(fmt.Sprintf("2.0/repositories/%s/%s/refs/%s", owner, repo, kind), bytes.NewBuffer(payload))
+
+	return err
+}
+
+// readRef reads a branch or tag, depending on kind.
+func readRef(client *Client, owner, repo, kind, name string) (*Ref, error) {
+	res, err := client.Get(fmt.Sprintf("2.0/repositories/%s/%s/refs/%s/%s", owner, repo, kind, url.PathEscape(name)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DEBUG] Ref Response JSON: %v", string(body))
+
+	var ref Ref
+	if err := json.Unmarshal(body, &ref); err != nil {
+		return nil, err
+	}
+
+	return &ref, nil
+}
+
+func refId(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, "/", 3)
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%q), expected OWNER/REPO/NAME", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
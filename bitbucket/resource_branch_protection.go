@@ -0,0 +1,487 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/DrFaust92/bitbucket-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// branchProtectionRule maps an attribute of bitbucket_branch_protection to the kind of
+// branch restriction it manages.
+type branchProtectionRule struct {
+	attribute string
+	kind      string
+}
+
+// Rules restricting who can do something, configured with users and groups.
+var branchProtectionAccessRules = []branchProtectionRule{
+	{"push", "push"},
+	{"restrict_merges", "restrict_merges"},
+}
+
+// Rules taking a numeric value.
+var branchProtectionValueRules = []branchProtectionRule{
+	{"required_approvals", "require_approvals_to_merge"},
+	{"required_default_reviewer_approvals", "require_default_reviewer_approvals_to_merge"},
+	{"required_passing_builds", "require_passing_builds_to_merge"},
+	{"max_commits_behind", "require_commits_behind"},
+}
+
+// Rules that are either on or off.
+var branchProtectionToggleRules = []branchProtectionRule{
+	{"no_force_push", "force"},
+	{"no_delete", "delete"},
+	{"require_tasks_completed", "require_tasks_to_be_completed"},
+	{"require_no_changes_requested", "require_no_changes_requested"},
+	{"require_all_dependencies_merged", "require_all_dependencies_merged"},
+	{"enforce_merge_checks", "enforce_merge_checks"},
+	{"reset_approvals_on_change", "reset_pullrequest_approvals_on_change"},
+	{"smart_reset_approvals", "smart_reset_pullrequest_approvals"},
+	{"reset_changes_requested_on_change", "reset_pullrequest_changes_requested_on_change"},
+	{"allow_auto_merge_when_builds_pass", "allow_auto_merge_when_builds_pass"},
+}
+
+func resourceBranchProtection() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"owner": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"repository": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"pattern": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"pattern", "branch_type"},
+		},
+		"branch_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice([]string{"feature", "bugfix", "release", "hotfix", "development", "production"}, false),
+		},
+	}
+
+	// A protection without any rule has no branch restriction to read back.
+	var ruleAttributes []string
+	for _, rules := range [][]branchProtectionRule{branchProtectionAccessRules, branchProtectionValueRules, branchProtectionToggleRules} {
+		for _, rule := range rules {
+			ruleAttributes = append(ruleAttributes, rule.attribute)
+		}
+	}
+
+	for _, rule := range branchProtectionAccessRules {
+		s[rule.attribute] = branchProtectionAccessSchema()
+		s[rule.attribute].AtLeastOneOf = ruleAttributes
+	}
+
+	for _, rule := range branchProtectionValueRules {
+		s[rule.attribute] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			AtLeastOneOf: ruleAttributes,
+		}
+	}
+
+	for _, rule := range branchProtectionToggleRules {
+		s[rule.attribute] = &schema.Schema{
+			Type:         schema.TypeBool,
+			Optional:     true,
+			Default:      false,
+			AtLeastOneOf: ruleAttributes,
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBranchProtectionCreate,
+		ReadWithoutTimeout:   resourceBranchProtectionRead,
+		UpdateWithoutTimeout: resourceBranchProtectionUpdate,
+		DeleteWithoutTimeout: resourceBranchProtectionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: s,
+	}
+}
+
+func branchProtectionAccessSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"users": {
+					Type:        schema.TypeSet,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Optional:    true,
+					Set:         schema.HashString,
+					Description: "The UUIDs of the users",
+				},
+				"groups": {
+					Type: schema.TypeSet,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"owner": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"slug": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceBranchProtectionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	owner := d.Get("owner").(string)
+	repo := d.Get("repository").(string)
+
+	// Toggles set to false don't create a restriction either.
+	if len(expandBranchProtection(d)) == 0 {
+		return diag.Errorf("at least one rule of the branch protection must be enabled")
+	}
+
+	if err := syncBranchProtection(d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", owner, repo, branchProtectionTarget(d.Get("pattern").(string), d.Get("branch_type").(string))))
+
+	return resourceBranchProtectionRead(ctx, d, m)
+}
+
+func resourceBranchProtectionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	owner, repo, pattern, branchType, err := branchProtectionId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	restrictions, err := branchProtectionRestrictions(&client, owner, repo, pattern, branchType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(restrictions) == 0 {
+		log.Printf("[WARN] Branch Protection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("owner", owner)
+	d.Set("repository", repo)
+	d.Set("pattern", pattern)
+	d.Set("branch_type", branchType)
+
+	for _, rule := range branchProtectionAccessRules {
+		var tfList []interface{}
+		if r, ok := restrictions[rule.kind]; ok {
+			tfList = flattenBranchProtectionAccess(r[0])
+		}
+		d.Set(rule.attribute, tfList)
+	}
+
+	for _, rule := range branchProtectionValueRules {
+		value := 0
+		if r, ok := restrictions[rule.kind]; ok {
+			value = int(r[0].Value)
+		}
+		d.Set(rule.attribute, value)
+	}
+
+	for _, rule := range branchProtectionToggleRules {
+		_, ok := restrictions[rule.kind]
+		d.Set(rule.attribute, ok)
+	}
+
+	return nil
+}
+
+func resourceBranchProtectionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := syncBranchProtection(d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceBranchProtectionRead(ctx, d, m)
+}
+
+func resourceBranchProtectionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(Clients).genClient
+	brApi := c.ApiClient.BranchRestrictionsApi
+	client := m.(Clients).httpClient
+
+	owner, repo, pattern, branchType, err := branchProtectionId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	restrictions, err := branchProtectionRestrictions(&client, owner, repo, pattern, branchType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, rs := range restrictions {
+		for _, r := range rs {
+			res, err := brApi.RepositoriesWorkspaceRepoSlugBranchRestrictionsIdDelete(c.AuthContext, fmt.Sprintf("%v", r.Id), repo, owner)
+			if res != nil && res.StatusCode == http.StatusNotFound {
+				continue
+			}
+			if err := handleClientError(res, err); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// syncBranchProtection makes the branch restrictions of the protected branches match the
+// configuration: missing ones are created, changed ones updated and the rest deleted. Only
+// the kinds the resource models are touched, and duplicates of those kinds are deleted.
+func syncBranchProtection(d *schema.ResourceData, m interface{}) error {
+	c := m.(Clients).genClient
+	brApi := c.ApiClient.BranchRestrictionsApi
+	client := m.(Clients).httpClient
+
+	owner := d.Get("owner").(string)
+	repo := d.Get("repository").(string)
+	pattern := d.Get("pattern").(string)
+	branchType := d.Get("branch_type").(string)
+
+	existing, err := branchProtectionRestrictions(&client, owner, repo, pattern, branchType)
+	if err != nil {
+		return err
+	}
+
+	desired := expandBranchProtection(d)
+
+	for attribute, restriction := range desired {
+		currents, ok := existing[restriction.Kind]
+		if !ok {
+			log.Printf("[DEBUG] Branch Protection creating %s restriction", restriction.Kind)
+			_, res, err := brApi.RepositoriesWorkspaceRepoSlugBranchRestrictionsPost(c.AuthContext, restriction, repo, owner)
+			if err := handleClientError(res, err); err != nil {
+				return err
+			}
+			continue
+		}
+
+		current := currents[0]
+		if d.HasChange(attribute) {
+			log.Printf("[DEBUG] Branch Protection updating %s restriction", restriction.Kind)
+			_, res, err := brApi.RepositoriesWorkspaceRepoSlugBranchRestrictionsIdPut(c.AuthContext, restriction, fmt.Sprintf("%v", current.Id), repo, owner)
+			if err := handleClientError(res, err); err != nil {
+				return err
+			}
+		}
+	}
+
+	for kind, currents := range existing {
+		stale := currents[1:]
+		if _, ok := desired[branchProtectionAttribute(kind)]; !ok {
+			stale = currents
+		}
+
+		for _, current := range stale {
+			log.Printf("[DEBUG] Branch Protection deleting %s restriction %v", kind, current.Id)
+			res, err := brApi.RepositoriesWorkspaceRepoSlugBranchRestrictionsIdDelete(c.AuthContext, fmt.Sprintf("%v", current.Id), repo, owner)
+			if res != nil && res.StatusCode == http.StatusNotFound {
+				continue
+			}
+			if err := handleClientError(res, err); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// expandBranchProtection returns the configured branch restrictions keyed by attribute.
+func expandBranchProtection(d *schema.ResourceData) map[string]bitbucket.Branchrestriction {
+	restrictions := make(map[string]bitbucket.Branchrestriction)
+
+	newRestriction := func(kind string) bitbucket.Branchrestriction {
+		r := bitbucket.Branchrestriction{
+			Kind:            kind,
+			BranchMatchKind: "glob",
+			Pattern:         d.Get("pattern").(string),
+		}
+
+		if v, ok := d.GetOk("branch_type"); ok {
+			r.BranchMatchKind = "branching_model"
+			r.BranchType = v.(string)
+			r.Pattern = ""
+		}
+
+		return r
+	}
+
+	for _, rule := range branchProtectionAccessRules {
+		tfList := d.Get(rule.attribute).([]interface{})
+		if len(tfList) == 0 {
+			continue
+		}
+
+		r := newRestriction(rule.kind)
+		r.Users = make([]bitbucket.Account, 0)
+		r.Groups = make([]bitbucket.Group, 0)
+
+		if tfMap, ok := tfList[0].(map[string]interface{}); ok {
+			for _, item := range tfMap["users"].(*schema.Set).List() {
+				r.Users = append(r.Users, bitbucket.Account{Uuid: item.(string)})
+			}
+
+			for _, item := range tfMap["groups"].(*schema.Set).List() {
+				group := item.(map[string]interface{})
+				r.Groups = append(r.Groups, bitbucket.Group{
+					Owner: &bitbucket.Account{Username: group["owner"].(string)},
+					Slug:  group["slug"].(string),
+				})
+			}
+		}
+
+		restrictions[rule.attribute] = r
+	}
+
+	for _, rule := range branchProtectionValueRules {
+		if v, ok := d.GetOk(rule.attribute); ok {
+			r := newRestriction(rule.kind)
+			r.Value = int32(v.(int))
+			restrictions[rule.attribute] = r
+		}
+	}
+
+	for _, rule := range branchProtectionToggleRules {
+		if d.Get(rule.attribute).(bool) {
+			restrictions[rule.attribute] = newRestriction(rule.kind)
+		}
+	}
+
+	return restrictions
+}
+
+func flattenBranchProtectionAccess(r bitbucket.Branchrestriction) []interface{} {
+	// Bitbucket Cloud no longer returns usernames, users are identified by UUID.
+	users := make([]interface{}, 0, len(r.Users))
+	for _, user := range r.Users {
+		users = append(users, user.Uuid)
+	}
+
+	groups := make([]interface{}, 0, len(r.Groups))
+	for _, group := range r.Groups {
+		owner := ""
+		if group.Owner != nil {
+			owner = group.Owner.Username
+		}
+
+		groups = append(groups, map[string]interface{}{
+			"owner": owner,
+			"slug":  group.Slug,
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"users":  users,
+		"groups": groups,
+	}}
+}
+
+// branchProtectionRestrictions returns the branch restrictions of a repository that apply to
+// the given pattern or branch type, keyed by kind. Kinds bitbucket_branch_protection doesn't
+// model are left out, so they are never changed or deleted by it.
+func branchProtectionRestrictions(client *Client, owner, repo, pattern, branchType string) (map[string][]bitbucket.Branchrestriction, error) {
+	restrictions, err := branchRestrictionsByPattern(client, owner, repo, pattern, branchType)
+	if err != nil {
+		return nil, err
+	}
+
+	for kind := range restrictions {
+		if branchProtectionAttribute(kind) == "" {
+			delete(restrictions, kind)
+		}
+	}
+
+	return restrictions, nil
+}
+
+// branchRestrictionsByPattern returns all branch restrictions of a repository that apply to
+// the given pattern or branch type, keyed by kind.
+func branchRestrictionsByPattern(client *Client, owner, repo, pattern, branchType string) (map[string][]bitbucket.Branchrestriction, error) {
+	restrictions, err := getAllPages[bitbucket.Branchrestriction](client, fmt.Sprintf("2.0/repositories/%s/%s/branch-restrictions", owner, repo))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string][]bitbucket.Branchrestriction)
+	for _, r := range restrictions {
+		if branchType != "" {
+			if r.BranchMatchKind != "branching_model" || r.BranchType != branchType {
+				continue
+			}
+		} else if (r.BranchMatchKind != "" && r.BranchMatchKind != "glob") || r.Pattern != pattern {
+			continue
+		}
+
+		res[r.Kind] = append(res[r.Kind], r)
+	}
+
+	return res, nil
+}
+
+func branchProtectionAttribute(kind string) string {
+	for _, rules := range [][]branchProtectionRule{branchProtectionAccessRules, branchProtectionValueRules, branchProtectionToggleRules} {
+		for _, rule := range rules {
+			if rule.kind == kind {
+				return rule.attribute
+			}
+		}
+	}
+
+	return ""
+}
+
+// branchProtectionTarget returns the last part of the ID, the pattern or, for branches
+// matched by the branching model, the branch type prefixed with "branching_model:".
+func branchProtectionTarget(pattern, branchType string) string {
+	if branchType != "" {
+		return "branching_model:" + branchType
+	}
+
+	return pattern
+}
+
+func branchProtectionId(id string) (string, string, string, string, error) {
+	parts := strings.SplitN(id, "/", 3)
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", "", fmt.Errorf("unexpected format of ID (%q), expected OWNER/REPO/PATTERN", id)
+	}
+
+	if branchType, ok := strings.CutPrefix(parts[2], "branching_model:"); ok {
+		return parts[0], parts[1], "", branchType, nil
+	}
+
+	return parts[0], parts[1], parts[2], "", nil
+}
@@ -0,0 +1,265 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBitbucketBranchProtection_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-test")
+	owner := os.Getenv("BITBUCKET_TEAM")
+	resourceName := "bitbucket_branch_protection.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketBranchProtectionConfig(owner, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s/%s/release/*", owner, rName)),
+					resource.TestCheckResourceAttr(resourceName, "pattern", "release/*"),
+					resource.TestCheckResourceAttr(resourceName, "required_approvals", "2"),
+					resource.TestCheckResourceAttr(resourceName, "no_force_push", "true"),
+					resource.TestCheckResourceAttr(resourceName, "no_delete", "true"),
+					resource.TestCheckResourceAttr(resourceName, "push.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBitbucketBranchProtectionUpdatedConfig(owner, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "required_approvals", "1"),
+					resource.TestCheckResourceAttr(resourceName, "required_passing_builds", "1"),
+					resource.TestCheckResourceAttr(resourceName, "no_force_push", "true"),
+					resource.TestCheckResourceAttr(resourceName, "no_delete", "false"),
+					resource.TestCheckResourceAttr(resourceName, "push.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitBitbucketBranchProtection_basic(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	rName := acctest.RandomWithPrefix("tf-test")
+	resourceName := "bitbucket_branch_protection.test"
+	restrictionsPath := fmt.Sprintf("repositories/%s/%s/branch-restrictions", fakeBitbucketTeam, rName)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		CheckDestroy:      fake.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitBitbucketBranchProtectionConfig(fakeBitbucketTeam, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s/%s/release/*", fakeBitbucketTeam, rName)),
+					resource.TestCheckResourceAttr(resourceName, "required_approvals", "2"),
+					resource.TestCheckResourceAttr(resourceName, "no_force_push", "true"),
+					resource.TestCheckResourceAttr(resourceName, "no_delete", "true"),
+					resource.TestCheckResourceAttr(resourceName, "require_tasks_completed", "false"),
+					resource.TestCheckResourceAttr(resourceName, "push.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "push.0.users.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "push.0.users.*", "{fake-user}"),
+					fake.CheckExists(restrictionsPath+"/4", true),
+					fake.CheckExists(restrictionsPath+"/5", false),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBitbucketBranchProtectionUpdatedConfig(fakeBitbucketTeam, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "required_approvals", "1"),
+					resource.TestCheckResourceAttr(resourceName, "required_passing_builds", "1"),
+					resource.TestCheckResourceAttr(resourceName, "no_force_push", "true"),
+					resource.TestCheckResourceAttr(resourceName, "no_delete", "false"),
+					resource.TestCheckResourceAttr(resourceName, "push.#", "0"),
+					fake.CheckExists(restrictionsPath+"/5", true),
+					fake.CheckExists(restrictionsPath+"/6", false),
+				),
+			},
+			{
+				Config: testAccBitbucketBranchProtectionModelConfig(fakeBitbucketTeam, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s/%s/branching_model:production", fakeBitbucketTeam, rName)),
+					resource.TestCheckResourceAttr(resourceName, "branch_type", "production"),
+					resource.TestCheckResourceAttr(resourceName, "no_force_push", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnitBitbucketBranchProtection_foreignRestrictions(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	rName := acctest.RandomWithPrefix("tf-test")
+	restrictionsPath := fmt.Sprintf("repositories/%s/%s/branch-restrictions", fakeBitbucketTeam, rName)
+
+	restriction := func(id int, kind string) map[string]interface{} {
+		return map[string]interface{}{
+			"id":                id,
+			"kind":              kind,
+			"branch_match_kind": "glob",
+			"pattern":           "release/*",
+		}
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		CheckDestroy:      fake.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testUnitBitbucketBranchProtectionNoRulesConfig(fakeBitbucketTeam, rName),
+				ExpectError: regexp.MustCompile("one of `"),
+			},
+			{
+				Config: testUnitBitbucketBranchProtectionConfig(fakeBitbucketTeam, rName),
+			},
+			{
+				PreConfig: func() {
+					fake.Put(restrictionsPath+"/100", restriction(100, "require_review_group_approvals_to_merge"))
+					fake.Put(restrictionsPath+"/101", restriction(101, "force"))
+				},
+				Config: testAccBitbucketBranchProtectionUpdatedConfig(fakeBitbucketTeam, rName),
+				Check: resource.ComposeTestCheckFunc(
+					fake.CheckExists(restrictionsPath+"/100", true),
+					fake.CheckExists(restrictionsPath+"/101", false),
+				),
+			},
+			{
+				Config: testUnitBitbucketBranchProtectionNoProtectionConfig(fakeBitbucketTeam, rName),
+				Check: resource.ComposeTestCheckFunc(
+					fake.CheckExists(restrictionsPath+"/100", true),
+					fake.CheckExists(restrictionsPath+"/5", false),
+				),
+			},
+		},
+	})
+}
+
+func testAccBitbucketBranchProtectionConfig(owner, rName string) string {
+	return fmt.Sprintf(`
+data "bitbucket_current_user" "test" {}
+
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_branch_protection" "test" {
+  owner      = %[1]q
+  repository = bitbucket_repository.test.name
+  pattern    = "release/*"
+
+  push {
+    users = [data.bitbucket_current_user.test.uuid]
+  }
+
+  required_approvals = 2
+  no_force_push      = true
+  no_delete          = true
+}
+`, owner, rName)
+}
+
+func testUnitBitbucketBranchProtectionConfig(owner, rName string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_branch_protection" "test" {
+  owner      = %[1]q
+  repository = bitbucket_repository.test.name
+  pattern    = "release/*"
+
+  push {
+    users = ["{fake-user}"]
+  }
+
+  required_approvals = 2
+  no_force_push      = true
+  no_delete          = true
+}
+`, owner, rName)
+}
+
+func testAccBitbucketBranchProtectionUpdatedConfig(owner, rName string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_branch_protection" "test" {
+  owner      = %[1]q
+  repository = bitbucket_repository.test.name
+  pattern    = "release/*"
+
+  required_approvals      = 1
+  required_passing_builds = 1
+  no_force_push           = true
+}
+`, owner, rName)
+}
+
+func testAccBitbucketBranchProtectionModelConfig(owner, rName string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_branch_protection" "test" {
+  owner       = %[1]q
+  repository  = bitbucket_repository.test.name
+  branch_type = "production"
+
+  no_force_push = true
+}
+`, owner, rName)
+}
+
+func testUnitBitbucketBranchProtectionNoRulesConfig(owner, rName string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_branch_protection" "test" {
+  owner      = %[1]q
+  repository = bitbucket_repository.test.name
+  pattern    = "release/*"
+}
+`, owner, rName)
+}
+
+func testUnitBitbucketBranchProtectionNoProtectionConfig(owner, rName string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+`, owner, rName)
+}
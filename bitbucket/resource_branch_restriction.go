@@ -46,11 +46,22 @@ func resourceBranchRestriction() *schema.Resource {
 		DeleteContext: resourceBranchRestrictionsDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "/")
-				if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
-					return nil, fmt.Errorf("unexpected format of ID (%q), expected OWNER/REPO/BRANCH-RESTRICTION-ID", d.Id())
+				idParts := strings.SplitN(d.Id(), "/", 4)
+				if len(idParts) < 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+					return nil, fmt.Errorf("unexpected format of ID (%q), expected OWNER/REPO/BRANCH-RESTRICTION-ID or OWNER/REPO/KIND/PATTERN", d.Id())
 				}
-				d.SetId(idParts[2])
+
+				id := idParts[2]
+				if len(idParts) == 4 {
+					client := meta.(Clients).httpClient
+					var err error
+					id, err = branchRestrictionIdByPattern(&client, idParts[0], idParts[1], idParts[2], idParts[3])
+					if err != nil {
+						return nil, err
+					}
+				}
+
+				d.SetId(id)
 				d.Set("owner", idParts[0])
 				d.Set("repository", idParts[1])
 				return []*schema.ResourceData{d}, nil
@@ -267,3 +278,22 @@ func resourceBranchRestrictionsDelete(ctx context.Context, d *schema.ResourceDat
 
 	return nil
 }
+
+// branchRestrictionIdByPattern looks up the ID of the branch restriction of the given kind
+// that applies to the given glob pattern.
+func branchRestrictionIdByPattern(client *Client, owner, repo, kind, pattern string) (string, error) {
+	restrictions, err := branchRestrictionsByPattern(client, owner, repo, pattern, "")
+	if err != nil {
+		return "", err
+	}
+
+	rs := restrictions[kind]
+	switch len(rs) {
+	case 0:
+		return "", fmt.Errorf("no %s branch restriction found for pattern %q in %s/%s", kind, pattern, owner, repo)
+	case 1:
+		return fmt.Sprintf("%v", rs[0].Id), nil
+	default:
+		return "", fmt.Errorf("%d %s branch restrictions found for pattern %q in %s/%s, import one by its ID instead", len(rs), kind, pattern, owner, repo)
+	}
+}
@@ -42,6 +42,46 @@ func TestAccBitbucketBranchRestriction_basic(t *testing.T) {
 	})
 }
 
+func TestUnitBitbucketBranchRestriction_basic(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	rName := acctest.RandomWithPrefix("tf-test")
+	resourceName := "bitbucket_branch_restriction.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		CheckDestroy:      fake.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketBranchRestrictionConfig(fakeBitbucketTeam, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "1"),
+					resource.TestCheckResourceAttr(resourceName, "kind", "force"),
+					resource.TestCheckResourceAttr(resourceName, "pattern", "master"),
+					resource.TestCheckResourceAttr(resourceName, "branch_match_kind", "glob"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccCheckBitbucketBranchRestrictionImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s/force/master", fakeBitbucketTeam, rName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBitbucketBranchRestrictionModelConfig(fakeBitbucketTeam, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "branch_match_kind", "branching_model"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBitbucketBranchRestriction_model(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-test")
 	testUser := os.Getenv("BITBUCKET_TEAM")
@@ -0,0 +1,98 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBitbucketBranch_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-test")
+	owner := os.Getenv("BITBUCKET_TEAM")
+	resourceName := "bitbucket_branch.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketBranchConfig(owner, rName, "development", "bitbucket_commit_file.test.branch"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "development"),
+					resource.TestCheckResourceAttr(resourceName, "source", "main"),
+					resource.TestCheckResourceAttrPair(resourceName, "hash", "bitbucket_commit_file.test", "commit_sha"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source"},
+			},
+		},
+	})
+}
+
+func TestUnitBitbucketBranch_basic(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	rName := acctest.RandomWithPrefix("tf-test")
+	resourceName := "bitbucket_branch.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		CheckDestroy:      fake.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketBranchConfig(fakeBitbucketTeam, rName, "release/1.0", "bitbucket_commit_file.test.branch"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s/%s/release/1.0", fakeBitbucketTeam, rName)),
+					resource.TestCheckResourceAttr(resourceName, "name", "release/1.0"),
+					resource.TestCheckResourceAttr(resourceName, "source", "main"),
+					resource.TestCheckResourceAttr(resourceName, "hash", fmt.Sprintf("%040x", 1)),
+					fake.CheckExists(fmt.Sprintf("repositories/%s/%s/refs/branches/release/1.0", fakeBitbucketTeam, rName), true),
+				),
+			},
+			{
+				// Changing the source doesn't move an existing branch.
+				Config:   testAccBitbucketBranchConfig(fakeBitbucketTeam, rName, "release/1.0", "bitbucket_commit_file.test.commit_sha"),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source"},
+			},
+		},
+	})
+}
+
+func testAccBitbucketBranchConfig(owner, rName, name, source string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_commit_file" "test" {
+  filename       = "README.md"
+  content        = "abc"
+  repo_slug      = bitbucket_repository.test.name
+  workspace      = bitbucket_repository.test.owner
+  commit_author  = "Unit test <unit@test.local>"
+  branch         = "main"
+  commit_message = "Initial commit"
+}
+
+resource "bitbucket_branch" "test" {
+  owner      = bitbucket_repository.test.owner
+  repository = bitbucket_repository.test.name
+  name       = %[3]q
+  source     = %[4]s
+}
+`, owner, rName, name, source)
+}
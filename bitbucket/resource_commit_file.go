@@ -4,12 +4,15 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"log"
 	"mime/multipart"
 	"net/http"
+	"sort"
 	"strings"
 
-	"github.com/DrFaust92/bitbucket-go-client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -17,10 +20,14 @@ func resourceCommitFile() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCommitFilePut,
 		ReadWithoutTimeout:   resourceCommitFileRead,
+		UpdateWithoutTimeout: resourceCommitFileUpdate,
 		DeleteWithoutTimeout: resourceCommitFileDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customdiff.ComputedIf("commit_sha", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+			return d.HasChange("content")
+		}),
 
 		Schema: map[string]*schema.Schema{
 			"workspace": {
@@ -36,7 +43,6 @@ func resourceCommitFile() *schema.Resource {
 			"content": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"filename": {
 				Type:     schema.TypeString,
@@ -51,14 +57,23 @@ func resourceCommitFile() *schema.Resource {
 			"commit_message": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "The SHA of the commit that modified the file",
-				ForceNew:    true,
+				Description: "The message of the commit that modifies the file",
 			},
 			"commit_author": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "The SHA of the commit that modified the file",
-				ForceNew:    true,
+				Description: "The author of the commit that modifies the file",
+			},
+			"delete_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Commit a deletion of the file when the resource is destroyed",
+			},
+			"destroy_commit_message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The message of the commit that deletes the file on destroy",
 			},
 			"commit_sha": {
 				Type:        schema.TypeString,
@@ -74,87 +89,230 @@ func resourceCommitFilePut(ctx context.Context, d *schema.ResourceData, m interf
 
 	repoSlug := d.Get("repo_slug").(string)
 	workspace := d.Get("workspace").(string)
-	content := d.Get("content").(string)
 	filename := d.Get("filename").(string)
 	branch := d.Get("branch").(string)
-	commitMessage := d.Get("commit_message").(string)
-	commitAuthor := d.Get("commit_author").(string)
 
-	body := &bytes.Buffer{}
-	writer := multipart.NewWriter(body)
-	part, _ := writer.CreateFormFile(filename, filename)
-	_, err := part.Write([]byte(content))
+	sha, err := commitSrc(&client, workspace, repoSlug, srcCommit{
+		Branch:  branch,
+		Message: d.Get("commit_message").(string),
+		Author:  d.Get("commit_author").(string),
+		Files:   map[string]string{filename: d.Get("content").(string)},
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	defer writer.Close()
 
-	messageFormField, err := writer.CreateFormField("message")
-	if err != nil {
-		return diag.FromErr(err)
+	d.SetId(formatCommitFileId(workspace, repoSlug, branch, filename))
+	d.Set("commit_sha", sha)
+
+	return resourceCommitFileRead(ctx, d, m)
+}
+
+func resourceCommitFileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Only a change of content needs a new commit, the other arguments only apply to it.
+	if !d.HasChange("content") {
+		return resourceCommitFileRead(ctx, d, m)
 	}
-	_, err = messageFormField.Write([]byte(commitMessage))
+
+	return resourceCommitFilePut(ctx, d, m)
+}
+
+func resourceCommitFileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace, repoSlug, branch, filename, err := commitFileId(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	authorFormField, err := writer.CreateFormField("author")
-	if err != nil {
-		return diag.FromErr(err)
+
+	// The file is read from the head of the branch, so edits made after Terraform
+	// committed it show up as a diff.
+	head, err := readRef(&client, workspace, repoSlug, "branches", branch)
+	if isNotFound(err) {
+		log.Printf("[WARN] Commit File (%s) branch not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
 	}
-	_, err = authorFormField.Write([]byte(commitAuthor))
+
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	branchFormField, err := writer.CreateFormField("branch")
-	if err != nil {
-		return diag.FromErr(err)
+	content, err := readSrcFile(&client, workspace, repoSlug, head.Target.Hash, filename)
+	if isNotFound(err) {
+		log.Printf("[WARN] Commit File (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
 	}
-	_, err = branchFormField.Write([]byte(branch))
+
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	res, err := client.PostWithContentType(fmt.Sprintf("2.0/repositories/%s/%s/src",
+	d.Set("workspace", workspace)
+	d.Set("repo_slug", repoSlug)
+	d.Set("branch", branch)
+	d.Set("filename", filename)
+	d.Set("content", content)
+
+	return nil
+}
+
+// commitFileId returns the workspace, repository, branch and filename of a commit file,
+// parsing them from the ID when they aren't known yet, e.g. on import.
+func commitFileId(d *schema.ResourceData) (string, string, string, string, error) {
+	if v, ok := d.GetOk("workspace"); ok {
+		return v.(string), d.Get("repo_slug").(string), d.Get("branch").(string), d.Get("filename").(string), nil
+	}
+
+	return parseCommitFileId(d.Id())
+}
+
+// formatCommitFileId returns the ID of a commit file. Branch names can contain slashes but
+// never colons, so the filename is separated by a colon whenever a slash would be ambiguous.
+func formatCommitFileId(workspace, repoSlug, branch, filename string) string {
+	if strings.Contains(branch, "/") || strings.Contains(filename, ":") {
+		return fmt.Sprintf("%s/%s/%s:%s", workspace, repoSlug, branch, filename)
+	}
+
+	return fmt.Sprintf("%s/%s/%s/%s", workspace, repoSlug, branch, filename)
+}
+
+// parseCommitFileId parses an ID in the WORKSPACE/REPO/BRANCH:FILENAME format, or in the
+// WORKSPACE/REPO/BRANCH/FILENAME format for branches without slashes.
+func parseCommitFileId(id string) (string, string, string, string, error) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) == 3 {
+		sep := "/"
+		if strings.Contains(parts[2], ":") {
+			sep = ":"
+		}
+
+		branch, filename, ok := strings.Cut(parts[2], sep)
+		if ok && parts[0] != "" && parts[1] != "" && branch != "" && filename != "" {
+			return parts[0], parts[1], branch, filename, nil
+		}
+	}
+
+	return "", "", "", "", fmt.Errorf("unexpected format of ID (%q), expected WORKSPACE/REPO/BRANCH:FILENAME or, for branches without slashes, WORKSPACE/REPO/BRANCH/FILENAME", id)
+}
+
+// readSrcFile returns the raw content of a file at a commit. Branches are resolved to their
+// head commit first, the src endpoint can't tell a branch with a slash from a directory.
+func readSrcFile(client *Client, workspace, repoSlug, hash, path string) (string, error) {
+	res, err := client.Get(fmt.Sprintf("2.0/repositories/%s/%s/src/%s/%s",
 		workspace,
 		repoSlug,
-	), writer.FormDataContentType(), body)
+		hash,
+		escapeSrcPath(path),
+	))
+	if err != nil {
+		return "", err
+	}
 
-	if err := handleClientError(res, err); err != nil {
-		return diag.FromErr(err)
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", err
 	}
 
-	if res.StatusCode != http.StatusCreated {
-		return diag.FromErr(fmt.Errorf(""))
+	return string(body), nil
+}
+
+func resourceCommitFileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !d.Get("delete_on_destroy").(bool) {
+		return nil
 	}
 
-	d.SetId(fmt.Sprintf("%s/%s/%s/%s", workspace, repoSlug, branch, filename))
+	client := m.(Clients).httpClient
+	filename := d.Get("filename").(string)
 
-	location, _ := res.Location()
-	splitPath := strings.Split(location.Path, "/")
-	d.Set("commit_sha", splitPath[len(splitPath)-1])
+	_, err := commitSrc(&client, d.Get("workspace").(string), d.Get("repo_slug").(string), srcCommit{
+		Branch:  d.Get("branch").(string),
+		Message: destroyCommitMessage(d, filename),
+		Author:  d.Get("commit_author").(string),
+		Deleted: []string{filename},
+	})
 
-	return resourceCommitFileRead(ctx, d, m)
+	return diag.FromErr(err)
 }
 
-func resourceCommitFileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(Clients).genClient
-	sourceApi := c.ApiClient.SourceApi
+// srcCommit is a commit made through the src endpoint of a repository.
+type srcCommit struct {
+	Branch  string
+	Message string
+	Author  string
+	// Files maps the path of every file to write to its content.
+	Files   map[string]string
+	Deleted []string
+}
 
-	repoSlug := d.Get("repo_slug").(string)
-	workspace := d.Get("workspace").(string)
-	filename := d.Get("filename").(string)
-	commit := d.Get("commit_sha").(string)
+// commitSrc commits files to a repository in a single commit and returns the hash of the commit.
+func commitSrc(client *Client, workspace, repoSlug string, commit srcCommit) (string, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
 
-	_, res, err := sourceApi.RepositoriesWorkspaceRepoSlugSrcCommitPathGet(c.AuthContext, commit, filename, repoSlug, workspace, &bitbucket.SourceApiRepositoriesWorkspaceRepoSlugSrcCommitPathGetOpts{})
+	paths := make([]string, 0, len(commit.Files))
+	for path := range commit.Files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
 
-	if err := handleClientError(res, err); err != nil {
-		return diag.FromErr(err)
+	for _, path := range paths {
+		part, err := writer.CreateFormFile(path, path)
+		if err != nil {
+			return "", err
+		}
+		if _, err := part.Write([]byte(commit.Files[path])); err != nil {
+			return "", err
+		}
 	}
 
-	return nil
+	fields := [][2]string{
+		{"message", commit.Message},
+		{"author", commit.Author},
+		{"branch", commit.Branch},
+	}
+	// Files listed in the files field without any content are deleted.
+	for _, path := range commit.Deleted {
+		fields = append(fields, [2]string{"files", path})
+	}
+
+	for _, field := range fields {
+		if err := writer.WriteField(field[0], field[1]); err != nil {
+			return "", err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+
+	res, err := client.PostWithContentType(fmt.Sprintf("2.0/repositories/%s/%s/src",
+		workspace,
+		repoSlug,
+	), writer.FormDataContentType(), body)
+	if err != nil {
+		return "", err
+	}
+
+	if res.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("unexpected status %d committing to %s/%s", res.StatusCode, workspace, repoSlug)
+	}
+
+	location, err := res.Location()
+	if err != nil {
+		return "", err
+	}
+
+	splitPath := strings.Split(location.Path, "/")
+	return splitPath[len(splitPath)-1], nil
 }
 
-func resourceCommitFileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	return nil
+// destroyCommitMessage returns the message of the commit deleting files on destroy.
+func destroyCommitMessage(d *schema.ResourceData, what string) string {
+	if v, ok := d.GetOk("destroy_commit_message"); ok {
+		return v.(string)
+	}
+
+	return fmt.Sprintf("Delete %s", what)
 }
@@ -9,6 +9,40 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
+func TestCommitFileId(t *testing.T) {
+	cases := []struct {
+		workspace, repoSlug, branch, filename string
+		id                                    string
+	}{
+		{"ws", "repo", "main", "CODEOWNERS", "ws/repo/main/CODEOWNERS"},
+		{"ws", "repo", "main", "docs/README.md", "ws/repo/main/docs/README.md"},
+		{"ws", "repo", "release/1.0", "CODEOWNERS", "ws/repo/release/1.0:CODEOWNERS"},
+		{"ws", "repo", "main", "docs/a:b.md", "ws/repo/main:docs/a:b.md"},
+	}
+
+	for _, tc := range cases {
+		if id := formatCommitFileId(tc.workspace, tc.repoSlug, tc.branch, tc.filename); id != tc.id {
+			t.Errorf("expected ID %q, got %q", tc.id, id)
+		}
+
+		workspace, repoSlug, branch, filename, err := parseCommitFileId(tc.id)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.id, err)
+			continue
+		}
+
+		if workspace != tc.workspace || repoSlug != tc.repoSlug || branch != tc.branch || filename != tc.filename {
+			t.Errorf("%s: parsed as %s, %s, %s, %s", tc.id, workspace, repoSlug, branch, filename)
+		}
+	}
+
+	for _, id := range []string{"ws/repo/main", "ws/repo/main:", "ws//main/README.md"} {
+		if _, _, _, _, err := parseCommitFileId(id); err == nil {
+			t.Errorf("%s: expected error, got nil", id)
+		}
+	}
+}
+
 func testAccBitbucketCommitFileConfig(owner, rName string) string {
 	return fmt.Sprintf(`
 resource "bitbucket_repository" "test" {
@@ -50,3 +84,174 @@ func TestAccBitbucketCommitFile_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccBitbucketCommitFile_update(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-test")
+	owner := os.Getenv("BITBUCKET_TEAM")
+	resourceName := "bitbucket_commit_file.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketCommitFileContentConfig(owner, rName, "abc"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "content", "abc"),
+					resource.TestCheckResourceAttrSet(resourceName, "commit_sha"),
+				),
+			},
+			{
+				Config: testAccBitbucketCommitFileContentConfig(owner, rName, "def"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "content", "def"),
+					resource.TestCheckResourceAttrSet(resourceName, "commit_sha"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitBitbucketCommitFile_drift(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	rName := acctest.RandomWithPrefix("tf-test")
+	resourceName := "bitbucket_commit_file.test"
+	repoPath := fmt.Sprintf("repositories/%s/%s", fakeBitbucketTeam, rName)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		CheckDestroy:      fake.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketCommitFileContentConfig(fakeBitbucketTeam, rName, "abc"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s/%s/main/README.md", fakeBitbucketTeam, rName)),
+					resource.TestCheckResourceAttr(resourceName, "content", "abc"),
+					resource.TestCheckResourceAttr(resourceName, "commit_sha", fmt.Sprintf("%040x", 1)),
+				),
+			},
+			{
+				// Editing the file outside of Terraform shows up as a diff.
+				PreConfig: func() {
+					fake.Commit(repoPath, "main", map[string]string{"README.md": "edited"})
+				},
+				Config:             testAccBitbucketCommitFileContentConfig(fakeBitbucketTeam, rName, "abc"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccBitbucketCommitFileContentConfig(fakeBitbucketTeam, rName, "abc"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "content", "abc"),
+					resource.TestCheckResourceAttr(resourceName, "commit_sha", fmt.Sprintf("%040x", 3)),
+				),
+			},
+			{
+				Config: testAccBitbucketCommitFileContentConfig(fakeBitbucketTeam, rName, "def"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "content", "def"),
+					resource.TestCheckResourceAttr(resourceName, "commit_sha", fmt.Sprintf("%040x", 4)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"commit_author", "commit_message", "commit_sha", "delete_on_destroy"},
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s/%s/main:README.md", fakeBitbucketTeam, rName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"commit_author", "commit_message", "commit_sha", "delete_on_destroy"},
+			},
+		},
+	})
+}
+
+func TestUnitBitbucketCommitFile_slashedBranch(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	rName := acctest.RandomWithPrefix("tf-test")
+	resourceName := "bitbucket_commit_file.test"
+	repoPath := fmt.Sprintf("repositories/%s/%s", fakeBitbucketTeam, rName)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		CheckDestroy:      fake.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitBitbucketCommitFileBranchConfig(fakeBitbucketTeam, rName, "feature/x"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s/%s/feature/x:README.md", fakeBitbucketTeam, rName)),
+					resource.TestCheckResourceAttr(resourceName, "branch", "feature/x"),
+					resource.TestCheckResourceAttr(resourceName, "content", "abc"),
+				),
+			},
+			{
+				// The file is read from the head of the slashed branch, not from a "x" directory
+				// of a "feature" branch.
+				PreConfig: func() {
+					fake.Commit(repoPath, "feature", map[string]string{"x/README.md": "abc"})
+					fake.Commit(repoPath, "feature/x", map[string]string{"README.md": "edited"})
+				},
+				Config:             testUnitBitbucketCommitFileBranchConfig(fakeBitbucketTeam, rName, "feature/x"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testUnitBitbucketCommitFileBranchConfig(fakeBitbucketTeam, rName, "feature/x"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "content", "abc"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"commit_author", "commit_message", "commit_sha", "delete_on_destroy"},
+			},
+		},
+	})
+}
+
+func testUnitBitbucketCommitFileBranchConfig(owner, rName, branch string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_commit_file" "test" {
+  filename          = "README.md"
+  content           = "abc"
+  repo_slug         = bitbucket_repository.test.name
+  workspace         = bitbucket_repository.test.owner
+  commit_author     = "Unit test <unit@test.local>"
+  branch            = %[3]q
+  commit_message    = "Update README"
+  delete_on_destroy = true
+}
+`, owner, rName, branch)
+}
+
+func testAccBitbucketCommitFileContentConfig(owner, rName, content string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_commit_file" "test" {
+  filename          = "README.md"
+  content           = %[3]q
+  repo_slug         = bitbucket_repository.test.name
+  workspace         = bitbucket_repository.test.owner
+  commit_author     = "Unit test <unit@test.local>"
+  branch            = "main"
+  commit_message    = "Update README"
+  delete_on_destroy = true
+}
+`, owner, rName, content)
+}
@@ -0,0 +1,274 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCommitFiles() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCommitFilesCreate,
+		ReadWithoutTimeout:   resourceCommitFilesRead,
+		UpdateWithoutTimeout: resourceCommitFilesUpdate,
+		DeleteWithoutTimeout: resourceCommitFilesDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				workspace, repoSlug, branch, paths, err := parseCommitFilesId(d.Id())
+				if err != nil {
+					return nil, err
+				}
+
+				// Only the paths are known, Read fills in their content.
+				files := make(map[string]interface{}, len(paths))
+				for _, path := range paths {
+					files[path] = ""
+				}
+
+				d.Set("workspace", workspace)
+				d.Set("repo_slug", repoSlug)
+				d.Set("branch", branch)
+				d.Set("files", files)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		CustomizeDiff: customdiff.ComputedIf("commit_sha", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+			return d.HasChange("files")
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repo_slug": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"branch": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"files": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The content of every file to commit, keyed by path",
+			},
+			"commit_message": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The message of the commit that modifies the files",
+			},
+			"commit_author": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The author of the commit that modifies the files",
+			},
+			"delete_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Commit a deletion of the files when the resource is destroyed",
+			},
+			"destroy_commit_message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The message of the commit that deletes the files on destroy",
+			},
+			"commit_sha": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA of the commit that last modified the files",
+			},
+		},
+	}
+}
+
+func resourceCommitFilesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	repoSlug := d.Get("repo_slug").(string)
+	branch := d.Get("branch").(string)
+
+	sha, err := commitSrc(&client, workspace, repoSlug, srcCommit{
+		Branch:  branch,
+		Message: d.Get("commit_message").(string),
+		Author:  d.Get("commit_author").(string),
+		Files:   expandCommitFiles(d.Get("files").(map[string]interface{})),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	files := make([]string, 0)
+	for path := range d.Get("files").(map[string]interface{}) {
+		files = append(files, path)
+	}
+
+	d.SetId(formatCommitFilesId(workspace, repoSlug, branch, files))
+	d.Set("commit_sha", sha)
+
+	return resourceCommitFilesRead(ctx, d, m)
+}
+
+func resourceCommitFilesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	workspace := d.Get("workspace").(string)
+	repoSlug := d.Get("repo_slug").(string)
+	branch := d.Get("branch").(string)
+
+	// The files are read from the head of the branch, so edits made after Terraform
+	// committed them show up as a diff. Deleted files are left out so they get committed again.
+	head, err := readRef(&client, workspace, repoSlug, "branches", branch)
+	if isNotFound(err) {
+		log.Printf("[WARN] Commit Files (%s) branch not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	files := make(map[string]interface{})
+	for path := range d.Get("files").(map[string]interface{}) {
+		content, err := readSrcFile(&client, workspace, repoSlug, head.Target.Hash, path)
+		if isNotFound(err) {
+			log.Printf("[WARN] Commit Files (%s) file %s not found", d.Id(), path)
+			continue
+		}
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		files[path] = content
+	}
+
+	d.Set("files", files)
+
+	return nil
+}
+
+func resourceCommitFilesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Only a change of files needs a new commit, the other arguments only apply to it.
+	if !d.HasChange("files") {
+		return resourceCommitFilesRead(ctx, d, m)
+	}
+
+	client := m.(Clients).httpClient
+
+	o, n := d.GetChange("files")
+	old := expandCommitFiles(o.(map[string]interface{}))
+	files := expandCommitFiles(n.(map[string]interface{}))
+
+	changed := make(map[string]string)
+	for path, content := range files {
+		if current, ok := old[path]; !ok || current != content {
+			changed[path] = content
+		}
+	}
+
+	var deleted []string
+	for path := range old {
+		if _, ok := files[path]; !ok {
+			deleted = append(deleted, path)
+		}
+	}
+	sort.Strings(deleted)
+
+	sha, err := commitSrc(&client, d.Get("workspace").(string), d.Get("repo_slug").(string), srcCommit{
+		Branch:  d.Get("branch").(string),
+		Message: d.Get("commit_message").(string),
+		Author:  d.Get("commit_author").(string),
+		Files:   changed,
+		Deleted: deleted,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("commit_sha", sha)
+
+	return resourceCommitFilesRead(ctx, d, m)
+}
+
+func resourceCommitFilesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !d.Get("delete_on_destroy").(bool) {
+		return nil
+	}
+
+	client := m.(Clients).httpClient
+
+	var paths []string
+	for path := range d.Get("files").(map[string]interface{}) {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	_, err := commitSrc(&client, d.Get("workspace").(string), d.Get("repo_slug").(string), srcCommit{
+		Branch:  d.Get("branch").(string),
+		Message: destroyCommitMessage(d, strings.Join(paths, ", ")),
+		Author:  d.Get("commit_author").(string),
+		Deleted: paths,
+	})
+
+	return diag.FromErr(err)
+}
+
+// formatCommitFilesId returns the ID of a set of committed files: the branch followed by a
+// colon, which branch names can't contain, and the comma separated paths. Two resources
+// can't manage the same file, so the paths make the ID unique. The ID keeps the paths the
+// resource was created or imported with.
+func formatCommitFilesId(workspace, repoSlug, branch string, paths []string) string {
+	sorted := append([]string(nil), paths...)
+	sort.Strings(sorted)
+
+	return fmt.Sprintf("%s/%s/%s:%s", workspace, repoSlug, branch, strings.Join(sorted, ","))
+}
+
+// parseCommitFilesId parses an ID in the WORKSPACE/REPO/BRANCH:PATH,PATH format.
+func parseCommitFilesId(id string) (string, string, string, []string, error) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" {
+		branch, paths, ok := strings.Cut(parts[2], ":")
+		if ok && branch != "" && paths != "" && !strings.Contains(","+paths+",", ",,") {
+			return parts[0], parts[1], branch, strings.Split(paths, ","), nil
+		}
+	}
+
+	return "", "", "", nil, fmt.Errorf("unexpected format of ID (%q), expected WORKSPACE/REPO/BRANCH:PATH,PATH", id)
+}
+
+func expandCommitFiles(tfMap map[string]interface{}) map[string]string {
+	files := make(map[string]string, len(tfMap))
+	for path, content := range tfMap {
+		files[path] = content.(string)
+	}
+
+	return files
+}
+
+// escapeSrcPath escapes every segment of a file path for use in a src URL.
+func escapeSrcPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,147 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBitbucketCommitFiles_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-test")
+	owner := os.Getenv("BITBUCKET_TEAM")
+	resourceName := "bitbucket_commit_files.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketCommitFilesConfig(owner, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "commit_sha"),
+				),
+			},
+			{
+				Config: testAccBitbucketCommitFilesUpdatedConfig(owner, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "files.CODEOWNERS", "* @platform @security"),
+					resource.TestCheckResourceAttrSet(resourceName, "commit_sha"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitBitbucketCommitFiles_basic(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	rName := acctest.RandomWithPrefix("tf-test")
+	resourceName := "bitbucket_commit_files.test"
+	repoPath := fmt.Sprintf("repositories/%s/%s", fakeBitbucketTeam, rName)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		CheckDestroy:      fake.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketCommitFilesConfig(fakeBitbucketTeam, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "commit_sha", fmt.Sprintf("%040x", 1)),
+					fake.CheckExists(fmt.Sprintf("%s/src/%040x/CODEOWNERS", repoPath, 1), true),
+					fake.CheckExists(fmt.Sprintf("%s/src/%040x/bitbucket-pipelines.yml", repoPath, 1), true),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s/%s/main:CODEOWNERS,bitbucket-pipelines.yml", fakeBitbucketTeam, rName)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"commit_author", "commit_message", "commit_sha", "delete_on_destroy"},
+			},
+			{
+				// Changed files are committed together with the deletion of removed ones.
+				Config: testAccBitbucketCommitFilesUpdatedConfig(fakeBitbucketTeam, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "commit_sha", fmt.Sprintf("%040x", 2)),
+					fake.CheckExists(fmt.Sprintf("%s/src/%040x/CODEOWNERS", repoPath, 2), true),
+					fake.CheckExists(fmt.Sprintf("%s/src/%040x/templates/pull_request.md", repoPath, 2), true),
+					fake.CheckExists(fmt.Sprintf("%s/src/%040x/bitbucket-pipelines.yml", repoPath, 2), false),
+				),
+			},
+			{
+				// Editing a file outside of Terraform shows up as a diff.
+				PreConfig: func() {
+					fake.Commit(repoPath, "main", map[string]string{"CODEOWNERS": "* @someone-else"})
+				},
+				Config:             testAccBitbucketCommitFilesUpdatedConfig(fakeBitbucketTeam, rName),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccBitbucketCommitFilesUpdatedConfig(fakeBitbucketTeam, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.CODEOWNERS", "* @platform @security"),
+					resource.TestCheckResourceAttr(resourceName, "commit_sha", fmt.Sprintf("%040x", 4)),
+				),
+			},
+			{
+				// Destroying the files commits their deletion.
+				Config: testAccBitbucketCommitFilesRepositoryConfig(fakeBitbucketTeam, rName),
+				Check: resource.ComposeTestCheckFunc(
+					fake.CheckExists(fmt.Sprintf("%s/commit/%040x", repoPath, 5), true),
+					fake.CheckExists(fmt.Sprintf("%s/src/%040x/CODEOWNERS", repoPath, 5), false),
+				),
+			},
+		},
+	})
+}
+
+func testAccBitbucketCommitFilesRepositoryConfig(owner, rName string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+`, owner, rName)
+}
+
+func testAccBitbucketCommitFilesConfig(owner, rName string) string {
+	return testAccBitbucketCommitFilesRepositoryConfig(owner, rName) + `
+resource "bitbucket_commit_files" "test" {
+  workspace         = bitbucket_repository.test.owner
+  repo_slug         = bitbucket_repository.test.name
+  branch            = "main"
+  commit_author     = "Unit test <unit@test.local>"
+  commit_message    = "Seed repository"
+  delete_on_destroy = true
+
+  files = {
+    "CODEOWNERS"              = "* @platform"
+    "bitbucket-pipelines.yml" = "image: atlassian/default-image:4"
+  }
+}
+`
+}
+
+func testAccBitbucketCommitFilesUpdatedConfig(owner, rName string) string {
+	return testAccBitbucketCommitFilesRepositoryConfig(owner, rName) + `
+resource "bitbucket_commit_files" "test" {
+  workspace         = bitbucket_repository.test.owner
+  repo_slug         = bitbucket_repository.test.name
+  branch            = "main"
+  commit_author     = "Unit test <unit@test.local>"
+  commit_message    = "Update repository seed"
+  delete_on_destroy = true
+
+  files = {
+    "CODEOWNERS"               = "* @platform @security"
+    "templates/pull_request.md" = "## Summary"
+  }
+}
+`
+}
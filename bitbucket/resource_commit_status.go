@@ -0,0 +1,215 @@
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// CommitStatus is a build status reported against a commit
+type CommitStatus struct {
+	Key         string `json:"key"`
+	State       string `json:"state"`
+	Name        string `json:"name,omitempty"`
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+	Refname     string `json:"refname,omitempty"`
+	CreatedOn   string `json:"created_on,omitempty"`
+	UpdatedOn   string `json:"updated_on,omitempty"`
+}
+
+func resourceCommitStatus() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCommitStatusCreate,
+		ReadWithoutTimeout:   resourceCommitStatusRead,
+		UpdateWithoutTimeout: resourceCommitStatusUpdate,
+		DeleteWithoutTimeout: resourceCommitStatusDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"owner": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"commit": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 40),
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"INPROGRESS", "SUCCESSFUL", "FAILED", "STOPPED"}, false),
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"refname": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"created_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createCommitStatus(d *schema.ResourceData) *CommitStatus {
+	return &CommitStatus{
+		Key:         d.Get("key").(string),
+		State:       d.Get("state").(string),
+		Name:        d.Get("name").(string),
+		URL:         d.Get("url").(string),
+		Description: d.Get("description").(string),
+		Refname:     d.Get("refname").(string),
+	}
+}
+
+func resourceCommitStatusCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+	status := createCommitStatus(d)
+
+	payload, err := json.Marshal(status)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	owner := d.Get("owner").(string)
+	repo := d.Get("repository").(string)
+	commit := d.Get("commit").(string)
+
+	_, err = client.Post(fmt.Sprintf("2.0/repositories/%s/%s/commit/%s/statuses/build", owner, repo, commit), bytes.NewBuffer(payload))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", owner, repo, commit, status.Key))
+
+	return resourceCommitStatusRead(ctx, d, m)
+}
+
+func resourceCommitStatusRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	owner, repo, commit, key, err := commitStatusId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := client.Get(fmt.Sprintf("2.0/repositories/%s/%s/commit/%s/statuses/build/%s", owner, repo, commit, url.PathEscape(key)))
+	if isNotFound(err) {
+		log.Printf("[WARN] Commit Status (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, readerr := io.ReadAll(res.Body)
+	if readerr != nil {
+		return diag.FromErr(readerr)
+	}
+
+	log.Printf("[DEBUG] Commit Status Response JSON: %v", string(body))
+
+	var status CommitStatus
+	decodeerr := json.Unmarshal(body, &status)
+	if decodeerr != nil {
+		return diag.FromErr(decodeerr)
+	}
+
+	d.Set("owner", owner)
+	d.Set("repository", repo)
+	d.Set("commit", commit)
+	d.Set("key", status.Key)
+	d.Set("state", status.State)
+	d.Set("name", status.Name)
+	d.Set("url", status.URL)
+	d.Set("description", status.Description)
+	d.Set("refname", status.Refname)
+	d.Set("created_on", status.CreatedOn)
+	d.Set("updated_on", status.UpdatedOn)
+
+	return nil
+}
+
+func resourceCommitStatusUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+	status := createCommitStatus(d)
+
+	payload, err := json.Marshal(status)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	owner, repo, commit, key, err := commitStatusId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.Put(fmt.Sprintf("2.0/repositories/%s/%s/commit/%s/statuses/build/%s", owner, repo, commit, url.PathEscape(key)), bytes.NewBuffer(payload))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCommitStatusRead(ctx, d, m)
+}
+
+func resourceCommitStatusDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Bitbucket has no API to remove a build status from a commit.
+	log.Printf("[WARN] Commit Status (%s) can't be deleted from Bitbucket, removing from state only", d.Id())
+	return nil
+}
+
+func commitStatusId(id string) (string, string, string, string, error) {
+	parts := strings.SplitN(id, "/", 4)
+
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		return "", "", "", "", fmt.Errorf("unexpected format of ID (%q), expected OWNER/REPO/SHA/KEY", id)
+	}
+
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
@@ -0,0 +1,115 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBitbucketCommitStatus_basic(t *testing.T) {
+	owner := os.Getenv("BITBUCKET_TEAM")
+	repo := os.Getenv("BITBUCKET_REPO")
+	commit := os.Getenv("BITBUCKET_COMMIT")
+	key := acctest.RandomWithPrefix("tf-test")
+	resourceName := "bitbucket_commit_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckRepo(t); testAccPreCheckFileCommit(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketCommitStatusConfig(owner, repo, commit, key, "INPROGRESS"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s/%s/%s/%s", owner, repo, commit, key)),
+					resource.TestCheckResourceAttr(resourceName, "state", "INPROGRESS"),
+					resource.TestCheckResourceAttr(resourceName, "name", "deploy"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_on"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBitbucketCommitStatusConfig(owner, repo, commit, key, "SUCCESSFUL"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "SUCCESSFUL"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitBitbucketCommitStatus_basic(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	rName := acctest.RandomWithPrefix("tf-test")
+	resourceName := "bitbucket_commit_status.test"
+	statusPath := fmt.Sprintf("repositories/%s/%s/commit/abc123/statuses/build/deployed-prod", fakeBitbucketTeam, rName)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		CheckDestroy:      fake.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitBitbucketCommitStatusConfig(fakeBitbucketTeam, rName, "INPROGRESS"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s/%s/abc123/deployed-prod", fakeBitbucketTeam, rName)),
+					resource.TestCheckResourceAttr(resourceName, "state", "INPROGRESS"),
+					resource.TestCheckResourceAttr(resourceName, "url", "https://ci.example.com/deploy/1"),
+					fake.CheckExists(statusPath, true),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testUnitBitbucketCommitStatusConfig(fakeBitbucketTeam, rName, "SUCCESSFUL"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s/%s/abc123/deployed-prod", fakeBitbucketTeam, rName)),
+					resource.TestCheckResourceAttr(resourceName, "state", "SUCCESSFUL"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBitbucketCommitStatusConfig(owner, repo, commit, key, state string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_commit_status" "test" {
+  owner       = %[1]q
+  repository  = %[2]q
+  commit      = %[3]q
+  key         = %[4]q
+  state       = %[5]q
+  name        = "deploy"
+  url         = "https://ci.example.com/deploy/1"
+  description = "Deployed to prod"
+}
+`, owner, repo, commit, key, state)
+}
+
+func testUnitBitbucketCommitStatusConfig(owner, rName, state string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_commit_status" "test" {
+  owner       = %[1]q
+  repository  = bitbucket_repository.test.name
+  commit      = "abc123"
+  key         = "deployed-prod"
+  state       = %[3]q
+  name        = "deploy"
+  url         = "https://ci.example.com/deploy/1"
+  description = "Deployed to prod"
+}
+`, owner, rName, state)
+}
@@ -0,0 +1,112 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDefaultReviewer() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDefaultReviewerCreate,
+		ReadWithoutTimeout:   resourceDefaultReviewerRead,
+		DeleteWithoutTimeout: resourceDefaultReviewerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"owner": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"reviewer": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceDefaultReviewerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(Clients).genClient
+	prApi := c.ApiClient.PullrequestsApi
+
+	owner := d.Get("owner").(string)
+	repo := d.Get("repository").(string)
+	reviewer := d.Get("reviewer").(string)
+
+	_, res, err := prApi.RepositoriesWorkspaceRepoSlugDefaultReviewersTargetUsernamePut(c.AuthContext, repo, reviewer, owner)
+	if err := handleClientError(res, err); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", owner, repo, reviewer))
+
+	return resourceDefaultReviewerRead(ctx, d, m)
+}
+
+func resourceDefaultReviewerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	owner, repo, reviewer, err := defaultReviewerId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.Get(fmt.Sprintf("2.0/repositories/%s/%s/default-reviewers/%s", owner, repo, url.PathEscape(reviewer)))
+	if isNotFound(err) {
+		log.Printf("[WARN] Default Reviewer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("owner", owner)
+	d.Set("repository", repo)
+	d.Set("reviewer", reviewer)
+
+	return nil
+}
+
+func resourceDefaultReviewerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(Clients).genClient
+	prApi := c.ApiClient.PullrequestsApi
+
+	owner, repo, reviewer, err := defaultReviewerId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := prApi.RepositoriesWorkspaceRepoSlugDefaultReviewersTargetUsernameDelete(c.AuthContext, repo, reviewer, owner)
+	if err := handleClientError(res, err); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func defaultReviewerId(id string) (string, string, string, error) {
+	parts := strings.Split(id, "/")
+
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%q), expected OWNER/REPOSITORY/REVIEWER-UUID", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
@@ -0,0 +1,97 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBitbucketDefaultReviewer_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-test")
+	owner := os.Getenv("BITBUCKET_TEAM")
+	resourceName := "bitbucket_default_reviewer.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketDefaultReviewerConfig(owner, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "repository", "bitbucket_repository.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "reviewer", "data.bitbucket_current_user.test", "uuid"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnitBitbucketDefaultReviewer_basic(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	rName := acctest.RandomWithPrefix("tf-test")
+	resourceName := "bitbucket_default_reviewer.a"
+	reviewersPath := fmt.Sprintf("repositories/%s/%s/default-reviewers", fakeBitbucketTeam, rName)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		CheckDestroy:      fake.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_default_reviewer" "a" {
+  owner      = %[1]q
+  repository = bitbucket_repository.test.name
+  reviewer   = "{a}"
+}
+
+resource "bitbucket_default_reviewer" "b" {
+  owner      = %[1]q
+  repository = bitbucket_repository.test.name
+  reviewer   = "{b}"
+}
+`, fakeBitbucketTeam, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s/%s/{a}", fakeBitbucketTeam, rName)),
+					fake.CheckExists(reviewersPath+"/{a}", true),
+					fake.CheckExists(reviewersPath+"/{b}", true),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBitbucketDefaultReviewerConfig(owner, rName string) string {
+	return fmt.Sprintf(`
+data "bitbucket_current_user" "test" {}
+
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_default_reviewer" "test" {
+  owner      = %[1]q
+  repository = bitbucket_repository.test.name
+  reviewer   = data.bitbucket_current_user.test.uuid
+}
+`, owner, rName)
+}
@@ -4,11 +4,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/http"
 	"strings"
 
 	"github.com/DrFaust92/bitbucket-go-client"
-	"github.com/antihax/optional"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -39,6 +37,11 @@ func resourceDefaultReviewers() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Required: true,
 			},
+			"authoritative": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 		},
 	}
 }
@@ -58,50 +61,48 @@ func resourceDefaultReviewersCreate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if isAuthoritative(d) {
+		client := m.(Clients).httpClient
+		current, err := repositoryDefaultReviewers(&client, workspace, repo)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, user := range current.Difference(d.Get("reviewers").(*schema.Set)).List() {
+			res, err := prApi.RepositoriesWorkspaceRepoSlugDefaultReviewersTargetUsernameDelete(c.AuthContext, repo, user.(string), workspace)
+			if err := handleClientError(res, err); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	d.SetId(fmt.Sprintf("%s/%s/reviewers", workspace, repo))
 	return resourceDefaultReviewersRead(ctx, d, m)
 }
 
 func resourceDefaultReviewersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(Clients).genClient
-	prApi := c.ApiClient.PullrequestsApi
-
-	options := bitbucket.PullrequestsApiRepositoriesWorkspaceRepoSlugDefaultReviewersGetOpts{}
+	client := m.(Clients).httpClient
 
 	owner, repo, err := defaultReviewersId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	var terraformReviewers []string
-
-	for {
-		reviewers, res, err := prApi.RepositoriesWorkspaceRepoSlugDefaultReviewersGet(c.AuthContext, repo, owner, &options)
-		if err := handleClientError(res, err); err != nil {
-			return diag.FromErr(err)
-		}
-
-		if res.StatusCode == http.StatusNotFound {
-			log.Printf("[WARN] Default Reviewers (%s) not found, removing from state", d.Id())
-			d.SetId("")
-			return nil
-		}
-
-		for _, reviewer := range reviewers.Values {
-			terraformReviewers = append(terraformReviewers, reviewer.Uuid)
-		}
+	reviewers, err := repositoryDefaultReviewers(&client, owner, repo)
+	if isNotFound(err) {
+		log.Printf("[WARN] Default Reviewers (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
 
-		if reviewers.Next != "" {
-			nextPage := reviewers.Page + 1
-			options.Page = optional.NewInt32(nextPage)
-		} else {
-			break
-		}
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.Set("owner", owner)
 	d.Set("repository", repo)
-	d.Set("reviewers", terraformReviewers)
+	d.Set("reviewers", managedReviewers(d, reviewers))
+	d.Set("authoritative", isAuthoritative(d))
 
 	return nil
 }
@@ -127,6 +128,16 @@ func resourceDefaultReviewersUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if isAuthoritative(d) {
+		client := m.(Clients).httpClient
+		current, err := repositoryDefaultReviewers(&client, workspace, repo)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		remove = current.Difference(n)
+	}
+
 	for _, user := range remove.List() {
 		userName := user.(string)
 		res, err := prApi.RepositoriesWorkspaceRepoSlugDefaultReviewersTargetUsernameDelete(c.AuthContext, repo, userName, workspace)
@@ -154,6 +165,62 @@ func resourceDefaultReviewersDelete(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
+// repositoryDefaultReviewers returns the reviewers configured directly on the repository.
+// Reviewers only inherited from the project are left out so that they can be managed
+// alongside the repository ones without showing up as drift.
+func repositoryDefaultReviewers(client *Client, owner, repo string) (*schema.Set, error) {
+	reviewers, err := getAllPages[bitbucket.Account](client, fmt.Sprintf("2.0/repositories/%s/%s/default-reviewers", owner, repo))
+	if err != nil {
+		return nil, err
+	}
+
+	effective, err := effectiveDefaultReviewers(client, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	inherited := make(map[string]bool)
+	for _, reviewer := range effective {
+		if reviewer.ReviewerType == "project" {
+			inherited[reviewer.User.Uuid] = true
+		}
+	}
+	for _, reviewer := range effective {
+		if reviewer.ReviewerType == "repository" {
+			delete(inherited, reviewer.User.Uuid)
+		}
+	}
+
+	uuids := schema.NewSet(schema.HashString, nil)
+	for _, reviewer := range reviewers {
+		if inherited[reviewer.Uuid] {
+			continue
+		}
+		uuids.Add(reviewer.Uuid)
+	}
+
+	return uuids, nil
+}
+
+// isAuthoritative reports whether the default reviewers resource owns the whole list of
+// reviewers. Imported resources have no value yet and are treated as authoritative.
+func isAuthoritative(d *schema.ResourceData) bool {
+	// nolint:staticcheck
+	v, ok := d.GetOkExists("authoritative")
+	return !ok || v.(bool)
+}
+
+// managedReviewers returns the reviewers to keep in state: all of them when the resource is
+// authoritative, otherwise only the ones it already manages, so that reviewers added outside
+// of Terraform are left alone.
+func managedReviewers(d *schema.ResourceData, reviewers *schema.Set) *schema.Set {
+	if isAuthoritative(d) {
+		return reviewers
+	}
+
+	return reviewers.Intersection(d.Get("reviewers").(*schema.Set))
+}
+
 func defaultReviewersId(id string) (string, string, error) {
 	parts := strings.Split(id, "/")
 
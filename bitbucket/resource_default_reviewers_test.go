@@ -39,6 +39,130 @@ func TestAccBitbucketDefaultReviewers_basic(t *testing.T) {
 	})
 }
 
+func TestUnitBitbucketDefaultReviewers_authoritative(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	rName := acctest.RandomWithPrefix("tf-test")
+	resourceName := "bitbucket_default_reviewers.test"
+	reviewersPath := fmt.Sprintf("repositories/%s/%s/default-reviewers", fakeBitbucketTeam, rName)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		CheckDestroy:      fake.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitBitbucketDefaultReviewersConfig(fakeBitbucketTeam, rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "authoritative", "true"),
+					resource.TestCheckResourceAttr(resourceName, "reviewers.#", "2"),
+					fake.CheckExists(reviewersPath+"/{a}", true),
+					fake.CheckExists(reviewersPath+"/{b}", true),
+				),
+			},
+			{
+				// A reviewer added outside of Terraform is drift and gets removed.
+				PreConfig: func() { fake.Put(reviewersPath+"/{c}", nil) },
+				Config:    testUnitBitbucketDefaultReviewersConfig(fakeBitbucketTeam, rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "reviewers.#", "2"),
+					fake.CheckExists(reviewersPath+"/{c}", false),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testUnitBitbucketDefaultReviewersConfig(fakeBitbucketTeam, rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "authoritative", "false"),
+				),
+			},
+			{
+				// A reviewer added outside of Terraform is left alone.
+				PreConfig: func() { fake.Put(reviewersPath+"/{d}", nil) },
+				Config:    testUnitBitbucketDefaultReviewersConfig(fakeBitbucketTeam, rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "reviewers.#", "2"),
+					fake.CheckExists(reviewersPath+"/{d}", true),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitBitbucketDefaultReviewers_projectOverlap(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	rName := acctest.RandomWithPrefix("tf-test")
+	resourceName := "bitbucket_default_reviewers.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		CheckDestroy:      fake.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				// A reviewer of both the project and the repository stays in the repository's set.
+				Config: testUnitBitbucketDefaultReviewersProjectOverlapConfig(fakeBitbucketTeam, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "reviewers.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "reviewers.*", "{r}"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testUnitBitbucketDefaultReviewersConfig(owner, rName string, authoritative bool) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_default_reviewers" "test" {
+  owner         = %[1]q
+  repository    = bitbucket_repository.test.name
+  reviewers     = ["{a}", "{b}"]
+  authoritative = %[3]t
+}
+`, owner, rName, authoritative)
+}
+
+func testUnitBitbucketDefaultReviewersProjectOverlapConfig(owner, rName string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_project" "test" {
+  owner = %[1]q
+  name  = %[2]q
+  key   = "TFREVOVL"
+}
+
+resource "bitbucket_project_default_reviewers" "test" {
+  workspace = %[1]q
+  project   = bitbucket_project.test.key
+  reviewers = ["{p}", "{r}"]
+}
+
+resource "bitbucket_repository" "test" {
+  owner       = %[1]q
+  name        = %[2]q
+  project_key = bitbucket_project.test.key
+}
+
+resource "bitbucket_default_reviewers" "test" {
+  owner      = %[1]q
+  repository = bitbucket_repository.test.name
+  reviewers  = ["{r}"]
+
+  depends_on = [bitbucket_project_default_reviewers.test]
+}
+`, owner, rName)
+}
+
 func testAccBitbucketDefaultReviewersConfig(owner, rName string) string {
 	return fmt.Sprintf(`
 data "bitbucket_current_user" "test" {}
@@ -17,10 +17,13 @@ import (
 
 // Deployment structure for handling key info
 type Deployment struct {
-	Name         string        `json:"name"`
-	Stage        *Stage        `json:"environment_type"`
-	UUID         string        `json:"uuid,omitempty"`
-	Restrictions *Restrictions `json:"restrictions,omitempty"`
+	Name                   string        `json:"name"`
+	Stage                  *Stage        `json:"environment_type"`
+	UUID                   string        `json:"uuid,omitempty"`
+	Restrictions           *Restrictions `json:"restrictions,omitempty"`
+	Rank                   int           `json:"rank,omitempty"`
+	EnvironmentLockEnabled bool          `json:"environment_lock_enabled,omitempty"`
+	Lock                   *Lock         `json:"lock,omitempty"`
 }
 
 type Stage struct {
@@ -28,7 +31,19 @@ type Stage struct {
 }
 
 type Restrictions struct {
-	AdminOnly bool `json:"admin_only"`
+	AdminOnly          bool             `json:"admin_only"`
+	BranchRestrictions []RefRestriction `json:"branch_restrictions"`
+	TagRestrictions    []RefRestriction `json:"tag_restrictions"`
+}
+
+// RefRestriction limits deployments to the branches or tags matching a pattern
+type RefRestriction struct {
+	Pattern string `json:"pattern"`
+}
+
+// Lock is the state of the lock Bitbucket holds on an environment while a deployment runs
+type Lock struct {
+	Name string `json:"name"`
 }
 
 type Changes struct {
@@ -36,8 +51,11 @@ type Changes struct {
 }
 
 type Change struct {
-	Name         string       `json:"name,omitempty"`
-	Restrictions Restrictions `json:"restrictions,omitempty"`
+	Name                   string        `json:"name,omitempty"`
+	Stage                  *Stage        `json:"environment_type,omitempty"`
+	Restrictions           *Restrictions `json:"restrictions,omitempty"`
+	Rank                   *int          `json:"rank,omitempty"`
+	EnvironmentLockEnabled *bool         `json:"environment_lock_enabled,omitempty"`
 }
 
 func resourceDeployment() *schema.Resource {
@@ -47,7 +65,19 @@ func resourceDeployment() *schema.Resource {
 		ReadWithoutTimeout:   resourceDeploymentRead,
 		DeleteWithoutTimeout: resourceDeploymentDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if strings.Contains(d.Id(), ":") {
+					return []*schema.ResourceData{d}, nil
+				}
+
+				idParts := strings.Split(d.Id(), "/")
+				if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+					return nil, fmt.Errorf("unexpected format of ID (%q), expected WORKSPACE/REPO/DEPLOYMENT-UUID or REPO-ID:DEPLOYMENT-UUID", d.Id())
+				}
+
+				d.SetId(fmt.Sprintf("%s/%s:%s", idParts[0], idParts[1], idParts[2]))
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -62,7 +92,6 @@ func resourceDeployment() *schema.Resource {
 			"stage": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					"Test",
 					"Staging",
@@ -87,9 +116,51 @@ func resourceDeployment() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
+						"branch_restriction": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Only allow deployments from the branches matching one of these patterns",
+							Elem:        refRestrictionResource(),
+						},
+						"tag_restriction": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Only allow deployments from the tags matching one of these patterns",
+							Elem:        refRestrictionResource(),
+						},
 					},
 				},
 			},
+			"rank": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The position of the environment within its stage",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"environment_lock_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Lock the environment while a deployment to it runs, so that deployments don't run concurrently",
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether a deployment currently holds the lock on the environment",
+			},
+		},
+	}
+}
+
+func refRestrictionResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"pattern": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
 		},
 	}
 }
@@ -146,6 +217,34 @@ func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, m int
 	d.Set("uuid", deployment.UUID)
 	d.SetId(fmt.Sprintf("%s:%s", d.Get("repository"), deployment.UUID))
 
+	// Branch and tag restrictions, rank and locking are only applied as changes to an
+	// existing environment.
+	change := &Change{}
+	apply := false
+
+	if rest := newDeploymentFromResource(d).Restrictions; rest != nil && (len(rest.BranchRestrictions) > 0 || len(rest.TagRestrictions) > 0) {
+		change.Restrictions = rest
+		apply = true
+	}
+
+	if v, ok := d.GetOk("rank"); ok {
+		rank := v.(int)
+		change.Rank = &rank
+		apply = true
+	}
+
+	if v, ok := d.GetOkExists("environment_lock_enabled"); ok { // nolint:staticcheck
+		enabled := v.(bool)
+		change.EnvironmentLockEnabled = &enabled
+		apply = true
+	}
+
+	if apply {
+		if err := changeDeployment(&client, d.Get("repository").(string), deployment.UUID, change); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceDeploymentRead(ctx, d, m)
 }
 
@@ -192,6 +291,9 @@ func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, m inter
 	d.Set("stage", deploy.Stage.Name)
 	d.Set("repository", repoId)
 	d.Set("restrictions", flattenRestrictions(deploy.Restrictions))
+	d.Set("rank", deploy.Rank)
+	d.Set("environment_lock_enabled", deploy.EnvironmentLockEnabled)
+	d.Set("locked", deploy.Lock != nil && deploy.Lock.Name == "LOCKED")
 
 	return nil
 }
@@ -207,36 +309,58 @@ func resourceDeploymentUpdate(ctx context.Context, d *schema.ResourceData, m int
 		rvcr.Change.Name = d.Get("name").(string)
 	}
 
+	// Moving the environment to another stage keeps its variables and deployment history.
+	if d.HasChange("stage") {
+		rvcr.Change.Stage = &Stage{
+			Name: d.Get("stage").(string),
+		}
+	}
+
+	// Restrictions are computed, removing the block keeps the current ones rather than
+	// clearing them, so only a configured block is sent.
 	if d.HasChange("restrictions") {
-		rvcr.Change.Restrictions = expandRestrictions(d.Get("restrictions").([]interface{}))
+		if v, ok := d.GetOk("restrictions"); ok {
+			rest := expandRestrictions(v.([]interface{}))
+			rvcr.Change.Restrictions = &rest
+		}
+	}
+
+	if d.HasChange("rank") {
+		rank := d.Get("rank").(int)
+		rvcr.Change.Rank = &rank
+	}
+
+	if d.HasChange("environment_lock_enabled") {
+		enabled := d.Get("environment_lock_enabled").(bool)
+		rvcr.Change.EnvironmentLockEnabled = &enabled
 	}
 
 	log.Printf("[DEBUG] deployment update req: %#v", rvcr)
 
-	bytedata, err := json.Marshal(rvcr)
+	if err := changeDeployment(&client, d.Get("repository").(string), d.Get("uuid").(string), rvcr.Change); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDeploymentRead(ctx, d, m)
+}
 
+// changeDeployment applies a change to an existing deployment environment.
+func changeDeployment(client *Client, repository, uuid string, change *Change) error {
+	bytedata, err := json.Marshal(&Changes{Change: change})
 	if err != nil {
-		return diag.FromErr(err)
+		return err
 	}
 
 	log.Printf("[DEBUG] deployment update req encoded: %v", string(bytedata))
 
-	req, err := client.Post(fmt.Sprintf("2.0/repositories/%s/environments/%s/changes/",
-		d.Get("repository").(string),
-		d.Get("uuid").(string),
+	res, err := client.Post(fmt.Sprintf("2.0/repositories/%s/environments/%s/changes/",
+		repository,
+		uuid,
 	), bytes.NewBuffer(bytedata))
 
-	log.Printf("[DEBUG] deployment update res: %#v", req)
-
-	if err != nil {
-		return diag.FromErr(err)
-	}
+	log.Printf("[DEBUG] deployment update res: %#v", res)
 
-	if req.StatusCode != 200 {
-		return nil
-	}
-
-	return resourceDeploymentRead(ctx, d, m)
+	return err
 }
 
 func resourceDeploymentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -260,19 +384,50 @@ func expandRestrictions(conf []interface{}) Restrictions {
 	tfMap, _ := conf[0].(map[string]interface{})
 
 	target := Restrictions{
-		AdminOnly: tfMap["admin_only"].(bool),
+		AdminOnly:          tfMap["admin_only"].(bool),
+		BranchRestrictions: []RefRestriction{},
+		TagRestrictions:    []RefRestriction{},
+	}
+
+	if v, ok := tfMap["branch_restriction"].(*schema.Set); ok {
+		target.BranchRestrictions = expandRefRestrictions(v.List())
+	}
+
+	if v, ok := tfMap["tag_restriction"].(*schema.Set); ok {
+		target.TagRestrictions = expandRefRestrictions(v.List())
 	}
 
 	return target
 }
 
+func expandRefRestrictions(tfList []interface{}) []RefRestriction {
+	restrictions := make([]RefRestriction, 0, len(tfList))
+	for _, v := range tfList {
+		tfMap, _ := v.(map[string]interface{})
+		restrictions = append(restrictions, RefRestriction{Pattern: tfMap["pattern"].(string)})
+	}
+
+	return restrictions
+}
+
+func flattenRefRestrictions(restrictions []RefRestriction) []interface{} {
+	tfList := make([]interface{}, 0, len(restrictions))
+	for _, r := range restrictions {
+		tfList = append(tfList, map[string]interface{}{"pattern": r.Pattern})
+	}
+
+	return tfList
+}
+
 func flattenRestrictions(rp *Restrictions) []interface{} {
 	if rp == nil {
 		return []interface{}{}
 	}
 
 	m := map[string]interface{}{
-		"admin_only": rp.AdminOnly,
+		"admin_only":         rp.AdminOnly,
+		"branch_restriction": flattenRefRestrictions(rp.BranchRestrictions),
+		"tag_restriction":    flattenRefRestrictions(rp.TagRestrictions),
 	}
 
 	return []interface{}{m}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -56,6 +57,41 @@ func TestAccBitbucketDeployment_basic(t *testing.T) {
 	})
 }
 
+func TestUnitBitbucketDeployment_basic(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	resourceName := "bitbucket_deployment.test"
+	rName := acctest.RandomWithPrefix("tf-test")
+	rNameUpdated := acctest.RandomWithPrefix("tf-test")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		CheckDestroy:      fake.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketDeployment(fakeBitbucketTeam, rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "stage", "Staging"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.admin_only", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "repository", "bitbucket_repository.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBitbucketDeployment(fakeBitbucketTeam, rName, rNameUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBitbucketDeployment_admin(t *testing.T) {
 	var deploy Deployment
 
@@ -111,6 +147,104 @@ func TestAccBitbucketDeployment_admin(t *testing.T) {
 	})
 }
 
+func TestUnitBitbucketDeployment_restrictions(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	resourceName := "bitbucket_deployment.test"
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		CheckDestroy:      fake.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketDeploymentRestrictions(fakeBitbucketTeam, rName, []string{"main", "release/*"}, nil, 2, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "restrictions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.admin_only", "true"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.branch_restriction.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "restrictions.0.branch_restriction.*", map[string]string{"pattern": "main"}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "restrictions.0.branch_restriction.*", map[string]string{"pattern": "release/*"}),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.tag_restriction.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rank", "2"),
+					resource.TestCheckResourceAttr(resourceName, "environment_lock_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "locked", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBitbucketDeploymentRestrictions(fakeBitbucketTeam, rName, []string{"main"}, []string{"v*"}, 0, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.branch_restriction.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "restrictions.0.branch_restriction.*", map[string]string{"pattern": "main"}),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.tag_restriction.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "restrictions.0.tag_restriction.*", map[string]string{"pattern": "v*"}),
+					resource.TestCheckResourceAttr(resourceName, "rank", "0"),
+					resource.TestCheckResourceAttr(resourceName, "environment_lock_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitBitbucketDeployment_stage(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	resourceName := "bitbucket_deployment.test"
+	rName := acctest.RandomWithPrefix("tf-test")
+	var uuid string
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		CheckDestroy:      fake.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketDeploymentStage(fakeBitbucketTeam, rName, "qa", "Test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "stage", "Test"),
+					func(s *terraform.State) error {
+						uuid = s.RootModule().Resources[resourceName].Primary.Attributes["uuid"]
+						return nil
+					},
+				),
+			},
+			{
+				// The environment is moved and renamed in place rather than replaced.
+				Config: testAccBitbucketDeploymentStage(fakeBitbucketTeam, rName, "uat", "Staging"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "uat"),
+					resource.TestCheckResourceAttr(resourceName, "stage", "Staging"),
+					resource.TestCheckResourceAttrPtr(resourceName, "uuid", &uuid),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccBitbucketDeploymentImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBitbucketDeploymentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["repository"], rs.Primary.Attributes["uuid"]), nil
+	}
+}
+
 func testAccCheckBitbucketDeploymentDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(Clients).httpClient
 	rs, ok := s.RootModule().Resources["bitbucket_deployment.test"]
@@ -173,3 +307,47 @@ resource "bitbucket_deployment" "test" {
 }
 `, workspace, repoName, deployName, admin)
 }
+
+func testAccBitbucketDeploymentRestrictions(workspace, repoName string, branches, tags []string, rank int, lock bool) string {
+	var restrictions strings.Builder
+	for _, pattern := range branches {
+		fmt.Fprintf(&restrictions, "\n    branch_restriction {\n      pattern = %q\n    }\n", pattern)
+	}
+	for _, pattern := range tags {
+		fmt.Fprintf(&restrictions, "\n    tag_restriction {\n      pattern = %q\n    }\n", pattern)
+	}
+
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_deployment" "test" {
+  name                     = "Production"
+  stage                    = "Production"
+  repository               = bitbucket_repository.test.id
+  rank                     = %[4]d
+  environment_lock_enabled = %[5]t
+
+  restrictions {
+    admin_only = true
+%[3]s  }
+}
+`, workspace, repoName, restrictions.String(), rank, lock)
+}
+
+func testAccBitbucketDeploymentStage(workspace, repoName, deployName, stage string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_deployment" "test" {
+  name       = %[3]q
+  stage      = %[4]q
+  repository = bitbucket_repository.test.id
+}
+`, workspace, repoName, deployName, stage)
+}
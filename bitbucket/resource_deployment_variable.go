@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/http"
 	"strings"
 	"time"
 
@@ -21,12 +20,25 @@ func resourceDeploymentVariable() *schema.Resource {
 		DeleteWithoutTimeout: resourceDeploymentVariableDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "/")
-				if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
-					return nil, fmt.Errorf("unexpected format of ID (%q), expected DEPLOYMENT-ID/DEPLOYMENT-VARIABLE-ID", d.Id())
+				idParts := strings.SplitN(d.Id(), "/", 4)
+				if len(idParts) < 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || (len(idParts) == 4 && idParts[3] == "") {
+					return nil, fmt.Errorf("unexpected format of ID (%q), expected DEPLOYMENT-ID/DEPLOYMENT-VARIABLE-ID or WORKSPACE/REPO/DEPLOYMENT-UUID/KEY", d.Id())
 				}
-				d.SetId(idParts[2])
-				d.Set("deployment", strings.Join([]string{idParts[0], idParts[1]}, "/"))
+
+				if len(idParts) == 3 {
+					d.SetId(idParts[2])
+					d.Set("deployment", strings.Join([]string{idParts[0], idParts[1]}, "/"))
+					return []*schema.ResourceData{d}, nil
+				}
+
+				client := meta.(Clients).httpClient
+				deployVar, err := deploymentVariableByKey(&client, idParts[0], idParts[1], idParts[2], idParts[3])
+				if err != nil {
+					return nil, err
+				}
+
+				d.SetId(deployVar.Uuid)
+				d.Set("deployment", fmt.Sprintf("%s/%s:%s", idParts[0], idParts[1], idParts[2]))
 				return []*schema.ResourceData{d}, nil
 			},
 		},
@@ -96,8 +108,7 @@ func resourceDeploymentVariableCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceDeploymentVariableRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(Clients).genClient
-	pipeApi := c.ApiClient.PipelinesApi
+	client := m.(Clients).httpClient
 
 	repository, deployment := parseDeploymentId(d.Get("deployment").(string))
 	workspace, repoSlug, err := deployVarId(repository)
@@ -105,27 +116,20 @@ func resourceDeploymentVariableRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	rvRes, res, err := pipeApi.GetDeploymentVariables(c.AuthContext, workspace, repoSlug, deployment, nil)
-
-	if res != nil && res.StatusCode == http.StatusNotFound {
+	deployVars, err := deploymentVariables(&client, workspace, repoSlug, deployment)
+	if isNotFound(err) {
 		log.Printf("[WARN] Deployment Variable (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
-	if err := handleClientError(res, err); err != nil {
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if rvRes.Size < 1 {
-		log.Printf("[WARN] Deployment Variable (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return nil
-	}
-
 	var deployVar *bitbucket.DeploymentVariable
 
-	for _, rv := range rvRes.Values {
+	for _, rv := range deployVars {
 		if rv.Uuid == d.Id() {
 			deployVar = &rv
 			break
@@ -188,6 +192,27 @@ func resourceDeploymentVariableDelete(ctx context.Context, d *schema.ResourceDat
 	return nil
 }
 
+// deploymentVariables lists the variables of a deployment environment.
+func deploymentVariables(client *Client, workspace, repoSlug, deployment string) ([]bitbucket.DeploymentVariable, error) {
+	return getAllPages[bitbucket.DeploymentVariable](client, fmt.Sprintf("2.0/repositories/%s/%s/deployments_config/environments/%s/variables",
+		workspace, repoSlug, deployment))
+}
+
+func deploymentVariableByKey(client *Client, workspace, repoSlug, deployment, key string) (*bitbucket.DeploymentVariable, error) {
+	deployVars, err := deploymentVariables(client, workspace, repoSlug, deployment)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rv := range deployVars {
+		if rv.Key == key {
+			return &rv, nil
+		}
+	}
+
+	return nil, fmt.Errorf("deployment variable %q not found in deployment %s of %s/%s", key, deployment, workspace, repoSlug)
+}
+
 func deployVarId(repo string) (string, string, error) {
 	idparts := strings.Split(repo, "/")
 	if len(idparts) == 2 {
@@ -51,6 +51,47 @@ func TestAccBitbucketDeploymentVariable_basic(t *testing.T) {
 	})
 }
 
+func TestUnitBitbucketDeploymentVariable_basic(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	rName := acctest.RandomWithPrefix("tf-test")
+	resourceName := "bitbucket_deployment_variable.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		CheckDestroy:      fake.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketDeploymentVariableConfig(fakeBitbucketTeam, rName, "test", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "deployment", "bitbucket_deployment.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "key", "test"),
+					resource.TestCheckResourceAttr(resourceName, "value", "test"),
+					resource.TestCheckResourceAttr(resourceName, "secured", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccBitbucketDeploymentVariableImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccBitbucketDeploymentVariableKeyImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBitbucketDeploymentVariableConfig(fakeBitbucketTeam, rName, "test-2", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value", "test-2"),
+					resource.TestCheckResourceAttr(resourceName, "secured", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBitbucketDeploymentVariable_manyVars(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-test")
 	owner := os.Getenv("BITBUCKET_TEAM")
@@ -213,3 +254,14 @@ func testAccBitbucketDeploymentVariableImportStateIdFunc(resourceName string) re
 		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["deployment"], rs.Primary.ID), nil
 	}
 }
+
+func testAccBitbucketDeploymentVariableKeyImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+		repository, deployment := parseDeploymentId(rs.Primary.Attributes["deployment"])
+		return fmt.Sprintf("%s/%s/%s", repository, deployment, rs.Primary.Attributes["key"]), nil
+	}
+}
@@ -0,0 +1,261 @@
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/DrFaust92/bitbucket-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDeploymentVariables() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDeploymentVariablesCreate,
+		ReadWithoutTimeout:   resourceDeploymentVariablesRead,
+		UpdateWithoutTimeout: resourceDeploymentVariablesUpdate,
+		DeleteWithoutTimeout: resourceDeploymentVariablesDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if !strings.Contains(d.Id(), ":") {
+					idParts := strings.Split(d.Id(), "/")
+					if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+						return nil, fmt.Errorf("unexpected format of ID (%q), expected WORKSPACE/REPO/DEPLOYMENT-UUID or DEPLOYMENT-ID", d.Id())
+					}
+					d.SetId(fmt.Sprintf("%s/%s:%s", idParts[0], idParts[1], idParts[2]))
+				}
+
+				d.Set("deployment", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"variables": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The plain variables of the deployment, keyed by name",
+			},
+			"secured_variables": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The secured variables of the deployment, keyed by name",
+			},
+		},
+	}
+}
+
+func resourceDeploymentVariablesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId(d.Get("deployment").(string))
+
+	if err := syncDeploymentVariables(d, m); err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+
+	return resourceDeploymentVariablesRead(ctx, d, m)
+}
+
+func resourceDeploymentVariablesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	repository, deployment := parseDeploymentId(d.Id())
+	workspace, repoSlug, err := deployVarId(repository)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deployVars, err := deploymentVariables(&client, workspace, repoSlug, deployment)
+	if isNotFound(err) {
+		log.Printf("[WARN] Deployment Variables (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Every variable of the deployment is managed, so ones added outside of Terraform
+	// show up as a diff. Secured values can't be read back and are kept from the state.
+	secured := d.Get("secured_variables").(map[string]interface{})
+	variables := make(map[string]interface{})
+	securedVariables := make(map[string]interface{})
+	for _, rv := range deployVars {
+		if rv.Secured {
+			value, _ := secured[rv.Key].(string)
+			securedVariables[rv.Key] = value
+		} else {
+			variables[rv.Key] = rv.Value
+		}
+	}
+
+	d.Set("deployment", d.Id())
+	d.Set("variables", variables)
+	d.Set("secured_variables", securedVariables)
+
+	return nil
+}
+
+func resourceDeploymentVariablesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := syncDeploymentVariables(d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDeploymentVariablesRead(ctx, d, m)
+}
+
+func resourceDeploymentVariablesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	repository, deployment := parseDeploymentId(d.Id())
+	workspace, repoSlug, err := deployVarId(repository)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deployVars, err := deploymentVariables(&client, workspace, repoSlug, deployment)
+	if isNotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The resource owns the whole environment, so every variable goes, including ones
+	// added since the last refresh.
+	for _, rv := range deployVars {
+		if err := deleteDeploymentVariable(&client, workspace, repoSlug, deployment, rv.Uuid); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// syncDeploymentVariables creates, updates and deletes the variables of the deployment so
+// they match the configuration. Unchanged variables aren't touched.
+func syncDeploymentVariables(d *schema.ResourceData, m interface{}) error {
+	client := m.(Clients).httpClient
+
+	repository, deployment := parseDeploymentId(d.Id())
+	workspace, repoSlug, err := deployVarId(repository)
+	if err != nil {
+		return err
+	}
+
+	desired := make(map[string]bitbucket.DeploymentVariable)
+	for key, value := range d.Get("variables").(map[string]interface{}) {
+		desired[key] = bitbucket.DeploymentVariable{Key: key, Value: value.(string)}
+	}
+	for key, value := range d.Get("secured_variables").(map[string]interface{}) {
+		if _, ok := desired[key]; ok {
+			return fmt.Errorf("variable %q is set in both variables and secured_variables", key)
+		}
+		desired[key] = bitbucket.DeploymentVariable{Key: key, Value: value.(string), Secured: true}
+	}
+
+	deployVars, err := deploymentVariables(&client, workspace, repoSlug, deployment)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]bitbucket.DeploymentVariable, len(deployVars))
+	for _, rv := range deployVars {
+		existing[rv.Key] = rv
+	}
+
+	o, _ := d.GetChange("secured_variables")
+	oldSecured := o.(map[string]interface{})
+
+	keys := make([]string, 0, len(desired))
+	for key := range desired {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	created := false
+	for _, key := range keys {
+		want := desired[key]
+		have, ok := existing[key]
+
+		switch {
+		case !ok:
+			created = true
+		case have.Secured && !want.Secured:
+			// A secured variable can't be made plain again, so it is replaced.
+			if err := deleteDeploymentVariable(&client, workspace, repoSlug, deployment, have.Uuid); err != nil {
+				return err
+			}
+			created = true
+		case want.Secured && have.Secured && oldSecured[key] == want.Value:
+			continue
+		case !want.Secured && !have.Secured && have.Value == want.Value:
+			continue
+		default:
+			log.Printf("[DEBUG] Deployment Variables (%s) updating %s", d.Id(), key)
+			if err := putDeploymentVariable(&client, workspace, repoSlug, deployment, have.Uuid, want); err != nil {
+				return err
+			}
+			continue
+		}
+
+		log.Printf("[DEBUG] Deployment Variables (%s) creating %s", d.Id(), key)
+		if err := putDeploymentVariable(&client, workspace, repoSlug, deployment, "", want); err != nil {
+			return err
+		}
+	}
+
+	for _, rv := range deployVars {
+		if _, ok := desired[rv.Key]; !ok {
+			log.Printf("[DEBUG] Deployment Variables (%s) deleting %s", d.Id(), rv.Key)
+			if err := deleteDeploymentVariable(&client, workspace, repoSlug, deployment, rv.Uuid); err != nil {
+				return err
+			}
+		}
+	}
+
+	if created {
+		time.Sleep(5000 * time.Millisecond) // sleep for a while, to allow BitBucket cache to catch up
+	}
+
+	return nil
+}
+
+// putDeploymentVariable creates the variable, or updates it when its UUID is given.
+func putDeploymentVariable(client *Client, workspace, repoSlug, deployment, uuid string, rv bitbucket.DeploymentVariable) error {
+	payload, err := json.Marshal(rv)
+	if err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%s/%s/deployments_config/environments/%s/variables", workspace, repoSlug, deployment)
+	if uuid == "" {
+		_, err = client.Post(endpoint, bytes.NewBuffer(payload))
+	} else {
+		_, err = client.Put(fmt.Sprintf("%s/%s", endpoint, uuid), bytes.NewBuffer(payload))
+	}
+
+	return err
+}
+
+func deleteDeploymentVariable(client *Client, workspace, repoSlug, deployment, uuid string) error {
+	_, err := client.Delete(fmt.Sprintf("2.0/repositories/%s/%s/deployments_config/environments/%s/variables/%s", workspace, repoSlug, deployment, uuid))
+
+	return err
+}
@@ -0,0 +1,162 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccBitbucketDeploymentVariables_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-test")
+	owner := os.Getenv("BITBUCKET_TEAM")
+	resourceName := "bitbucket_deployment_variables.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketDeploymentVariablesConfig(owner, rName, map[string]string{"REGION": "eu-west-1"}, map[string]string{"TOKEN": "s3cret"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "deployment", "bitbucket_deployment.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "variables.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "variables.REGION", "eu-west-1"),
+					resource.TestCheckResourceAttr(resourceName, "secured_variables.%", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secured_variables"},
+			},
+		},
+	})
+}
+
+func TestUnitBitbucketDeploymentVariables_basic(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	rName := acctest.RandomWithPrefix("tf-test")
+	resourceName := "bitbucket_deployment_variables.test"
+	var deployment string
+
+	variables := make(map[string]string)
+	for i := 0; i < 12; i++ {
+		variables[fmt.Sprintf("VAR%02d", i)] = "value"
+	}
+
+	updated := make(map[string]string)
+	for key, value := range variables {
+		updated[key] = value
+	}
+	updated["VAR00"] = "changed"
+	updated["VAR12"] = "added"
+	delete(updated, "VAR01")
+	delete(updated, "VAR11")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		CheckDestroy:      fake.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketDeploymentVariablesConfig(fakeBitbucketTeam, rName, variables, map[string]string{"TOKEN": "s3cret"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "bitbucket_deployment.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "variables.%", "12"),
+					resource.TestCheckResourceAttr(resourceName, "variables.VAR11", "value"),
+					resource.TestCheckResourceAttr(resourceName, "secured_variables.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "secured_variables.TOKEN", "s3cret"),
+					func(s *terraform.State) error {
+						deployment = s.RootModule().Resources[resourceName].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				// Variables added outside of Terraform show up as a diff.
+				PreConfig: func() {
+					repository, uuid := parseDeploymentId(deployment)
+					fake.Put(fmt.Sprintf("repositories/%s/deployments_config/environments/%s/variables/{extra}", repository, uuid), map[string]interface{}{
+						"uuid":  "{extra}",
+						"key":   "EXTRA",
+						"value": "value",
+					})
+				},
+				Config:             testAccBitbucketDeploymentVariablesConfig(fakeBitbucketTeam, rName, variables, map[string]string{"TOKEN": "s3cret"}),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccBitbucketDeploymentVariablesConfig(fakeBitbucketTeam, rName, updated, map[string]string{"TOKEN": "s3cret", "VAR01": "secured"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "variables.%", "11"),
+					resource.TestCheckResourceAttr(resourceName, "variables.VAR00", "changed"),
+					resource.TestCheckResourceAttr(resourceName, "variables.VAR12", "added"),
+					resource.TestCheckNoResourceAttr(resourceName, "variables.VAR01"),
+					resource.TestCheckNoResourceAttr(resourceName, "variables.VAR11"),
+					resource.TestCheckNoResourceAttr(resourceName, "variables.EXTRA"),
+					resource.TestCheckResourceAttr(resourceName, "secured_variables.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "secured_variables.VAR01", "secured"),
+				),
+			},
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					repository, uuid := parseDeploymentId(s.RootModule().Resources[resourceName].Primary.ID)
+					return fmt.Sprintf("%s/%s", repository, uuid), nil
+				},
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secured_variables"},
+			},
+		},
+	})
+}
+
+func testAccBitbucketDeploymentVariablesConfig(owner, rName string, variables, secured map[string]string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_deployment" "test" {
+  name       = %[2]q
+  stage      = "Test"
+  repository = bitbucket_repository.test.id
+}
+
+resource "bitbucket_deployment_variables" "test" {
+  deployment = bitbucket_deployment.test.id
+
+  variables = {
+%[3]s  }
+
+  secured_variables = {
+%[4]s  }
+}
+`, owner, rName, testAccHclMap(variables), testAccHclMap(secured))
+}
+
+// testAccHclMap renders the entries of an HCL map, one per line.
+func testAccHclMap(m map[string]string) string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, key := range keys {
+		fmt.Fprintf(&b, "    %s = %q\n", key, m[key])
+	}
+
+	return b.String()
+}
@@ -78,6 +78,46 @@ func TestAccBitbucketHook_basic(t *testing.T) {
 	})
 }
 
+func TestUnitBitbucketHook_basic(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	resourceName := "bitbucket_hook.test"
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		CheckDestroy:      fake.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketHookConfig(fakeBitbucketTeam, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "uuid"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Test hook for terraform"),
+					resource.TestCheckResourceAttr(resourceName, "skip_cert_verification", "true"),
+					resource.TestCheckResourceAttr(resourceName, "secret_set", "false"),
+					resource.TestCheckResourceAttr(resourceName, "active", "true"),
+					resource.TestCheckResourceAttr(resourceName, "events.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccBitbucketHookImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBitbucketHookConfigUpdated(fakeBitbucketTeam, rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "Test hook for terraform Updated"),
+					resource.TestCheckResourceAttr(resourceName, "skip_cert_verification", "false"),
+					resource.TestCheckResourceAttr(resourceName, "secret_set", "true"),
+					resource.TestCheckResourceAttr(resourceName, "active", "false"),
+					resource.TestCheckResourceAttr(resourceName, "events.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestEncodesJsonCompletely(t *testing.T) {
 	hook := &Hook{
 		UUID:        uuid.NewV4().String(),
@@ -4,15 +4,19 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/http"
 	"strings"
 
 	"github.com/DrFaust92/bitbucket-go-client"
-	"github.com/antihax/optional"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// DefaultReviewer is a default reviewer along with where it was configured.
+type DefaultReviewer struct {
+	User         bitbucket.Account `json:"user"`
+	ReviewerType string            `json:"reviewer_type,omitempty"`
+}
+
 func resourceProjectDefaultReviewers() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceProjectDefaultReviewersCreate,
@@ -39,6 +43,11 @@ func resourceProjectDefaultReviewers() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Required: true,
 			},
+			"authoritative": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 		},
 	}
 }
@@ -58,49 +67,48 @@ func resourceProjectDefaultReviewersCreate(ctx context.Context, d *schema.Resour
 		}
 	}
 
+	if isAuthoritative(d) {
+		client := m.(Clients).httpClient
+		current, err := projectDefaultReviewers(&client, workspace, project)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, user := range current.Difference(d.Get("reviewers").(*schema.Set)).List() {
+			res, err := projectsApi.WorkspacesWorkspaceProjectsProjectKeyDefaultReviewersSelectedUserDelete(c.AuthContext, project, user.(string), workspace)
+			if err := handleClientError(res, err); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	d.SetId(fmt.Sprintf("%s/%s", workspace, project))
 	return resourceProjectDefaultReviewersRead(ctx, d, m)
 }
 
 func resourceProjectDefaultReviewersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(Clients).genClient
-	projectsApi := c.ApiClient.ProjectsApi
+	client := m.(Clients).httpClient
 
 	workspace, project, err := defaultProjectReviewersId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	options := bitbucket.ProjectsApiWorkspacesWorkspaceProjectsProjectKeyDefaultReviewersGetOpts{}
-	var terraformReviewers []string
-
-	for {
-		reviewers, res, err := projectsApi.WorkspacesWorkspaceProjectsProjectKeyDefaultReviewersGet(c.AuthContext, project, workspace, &options)
-		if err := handleClientError(res, err); err != nil {
-			return diag.FromErr(err)
-		}
-
-		if res.StatusCode == http.StatusNotFound {
-			log.Printf("[WARN] Project Default Reviewers (%s) not found, removing from state", d.Id())
-			d.SetId("")
-			return nil
-		}
-
-		for _, reviewer := range reviewers.Values {
-			terraformReviewers = append(terraformReviewers, reviewer.User.Uuid)
-		}
+	reviewers, err := projectDefaultReviewers(&client, workspace, project)
+	if isNotFound(err) {
+		log.Printf("[WARN] Project Default Reviewers (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
 
-		if reviewers.Next != "" {
-			nextPage := reviewers.Page + 1
-			options.Page = optional.NewInt32(nextPage)
-		} else {
-			break
-		}
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.Set("workspace", workspace)
 	d.Set("project", project)
-	d.Set("reviewers", terraformReviewers)
+	d.Set("reviewers", managedReviewers(d, reviewers))
+	d.Set("authoritative", isAuthoritative(d))
 
 	return nil
 }
@@ -126,6 +134,16 @@ func resourceProjectDefaultReviewersUpdate(ctx context.Context, d *schema.Resour
 		}
 	}
 
+	if isAuthoritative(d) {
+		client := m.(Clients).httpClient
+		current, err := projectDefaultReviewers(&client, workspace, project)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		remove = current.Difference(n)
+	}
+
 	for _, user := range remove.List() {
 		userName := user.(string)
 		res, err := projectsApi.WorkspacesWorkspaceProjectsProjectKeyDefaultReviewersSelectedUserDelete(c.AuthContext, project, userName, workspace)
@@ -153,6 +171,20 @@ func resourceProjectDefaultReviewersDelete(ctx context.Context, d *schema.Resour
 	return nil
 }
 
+func projectDefaultReviewers(client *Client, workspace, project string) (*schema.Set, error) {
+	reviewers, err := getAllPages[DefaultReviewer](client, fmt.Sprintf("2.0/workspaces/%s/projects/%s/default-reviewers", workspace, project))
+	if err != nil {
+		return nil, err
+	}
+
+	uuids := schema.NewSet(schema.HashString, nil)
+	for _, reviewer := range reviewers {
+		uuids.Add(reviewer.User.Uuid)
+	}
+
+	return uuids, nil
+}
+
 func defaultProjectReviewersId(id string) (string, string, error) {
 	parts := strings.Split(id, "/")
 
@@ -39,6 +39,63 @@ func TestAccBitbucketProjectDefaultReviewers_basic(t *testing.T) {
 	})
 }
 
+func TestUnitBitbucketProjectDefaultReviewers_authoritative(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	rName := acctest.RandomWithPrefix("tf-test")
+	resourceName := "bitbucket_project_default_reviewers.test"
+	reviewersPath := fmt.Sprintf("workspaces/%s/projects/CCCCCCCC/default-reviewers", fakeBitbucketTeam)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		CheckDestroy:      fake.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitBitbucketProjectDefaultReviewersConfig(fakeBitbucketTeam, rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "reviewers.#", "2"),
+					fake.CheckExists(reviewersPath+"/{a}", true),
+				),
+			},
+			{
+				PreConfig: func() { fake.Put(reviewersPath+"/{c}", nil) },
+				Config:    testUnitBitbucketProjectDefaultReviewersConfig(fakeBitbucketTeam, rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "reviewers.#", "2"),
+					fake.CheckExists(reviewersPath+"/{c}", false),
+				),
+			},
+			{
+				Config: testUnitBitbucketProjectDefaultReviewersConfig(fakeBitbucketTeam, rName, false),
+			},
+			{
+				PreConfig: func() { fake.Put(reviewersPath+"/{d}", nil) },
+				Config:    testUnitBitbucketProjectDefaultReviewersConfig(fakeBitbucketTeam, rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "reviewers.#", "2"),
+					fake.CheckExists(reviewersPath+"/{d}", true),
+				),
+			},
+		},
+	})
+}
+
+func testUnitBitbucketProjectDefaultReviewersConfig(workspace, rName string, authoritative bool) string {
+	return fmt.Sprintf(`
+resource "bitbucket_project" "test" {
+  owner = %[1]q
+  name  = %[2]q
+  key   = "CCCCCCCC"
+}
+
+resource "bitbucket_project_default_reviewers" "test" {
+  workspace     = %[1]q
+  project       = bitbucket_project.test.key
+  reviewers     = ["{a}", "{b}"]
+  authoritative = %[3]t
+}
+`, workspace, rName, authoritative)
+}
+
 func testAccBitbucketProjectDefaultReviewersConfig(workspace, rName string) string {
 	return fmt.Sprintf(`
 data "bitbucket_current_user" "test" {}
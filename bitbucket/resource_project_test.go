@@ -62,6 +62,41 @@ func TestAccBitbucketProject_basic(t *testing.T) {
 	})
 }
 
+func TestUnitBitbucketProject_basic(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	resourceName := "bitbucket_project.test"
+	rName := acctest.RandomWithPrefix("tf-test")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		CheckDestroy:      fake.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketProjectConfig(fakeBitbucketTeam, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s/AAAAAA", fakeBitbucketTeam)),
+					resource.TestCheckResourceAttr(resourceName, "key", "AAAAAA"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "is_private", "true"),
+					resource.TestCheckResourceAttr(resourceName, "has_publicly_visible_repos", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "uuid"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBitbucketProjectDescConfig(fakeBitbucketTeam, rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBitbucketProject_avatar(t *testing.T) {
 	resourceName := "bitbucket_project.test"
 	testTeam := os.Getenv("BITBUCKET_TEAM")
@@ -123,6 +123,47 @@ func TestAccBitbucketRepository_basic(t *testing.T) {
 	})
 }
 
+func TestUnitBitbucketRepository_basic(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	rName := acctest.RandomWithPrefix("tf-test")
+	resourceName := "bitbucket_repository.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		CheckDestroy:      fake.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketRepoConfig(fakeBitbucketTeam, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "owner", fakeBitbucketTeam),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "slug", rName),
+					resource.TestCheckResourceAttr(resourceName, "is_private", "true"),
+					resource.TestCheckResourceAttr(resourceName, "project_key", "FAKE"),
+					resource.TestCheckResourceAttr(resourceName, "pipelines_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "inherit_default_merge_strategy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "inherit_branching_model", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "uuid"),
+					resource.TestCheckResourceAttrSet(resourceName, "clone_https"),
+					resource.TestCheckResourceAttrSet(resourceName, "clone_ssh"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBitbucketRepoInheritConfig(fakeBitbucketTeam, rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "inherit_default_merge_strategy", "false"),
+					resource.TestCheckResourceAttr(resourceName, "inherit_branching_model", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBitbucketRepository_project(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-test")
 	workspace := os.Getenv("BITBUCKET_TEAM")
@@ -53,6 +53,41 @@ func TestAccBitbucketRepositoryVariable_basic(t *testing.T) {
 	})
 }
 
+func TestUnitBitbucketRepositoryVariable_basic(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	rName := acctest.RandomWithPrefix("tf-test")
+	resourceName := "bitbucket_repository_variable.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		CheckDestroy:      fake.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketRepositoryVariableConfig(fakeBitbucketTeam, rName, "test-val"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "repository", "bitbucket_repository.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "key", "test"),
+					resource.TestCheckResourceAttr(resourceName, "value", "test-val"),
+					resource.TestCheckResourceAttr(resourceName, "secured", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "uuid"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccBitbucketRepoVariableImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBitbucketRepositoryVariableConfig(fakeBitbucketTeam, rName, "test-val-2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value", "test-val-2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckBitbucketRepositoryVariableDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(Clients).genClient
 	pipeApi := client.ApiClient.PipelinesApi
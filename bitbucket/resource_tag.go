@@ -0,0 +1,130 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTag() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTagCreate,
+		ReadWithoutTimeout:   resourceTagRead,
+		DeleteWithoutTimeout: resourceTagDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"owner": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"target": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The commit hash the tag points at",
+				// Bitbucket accepts abbreviated hashes but always returns the full one.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return new != "" && strings.HasPrefix(old, new)
+				},
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The message of the tag",
+			},
+			"date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the tag was created",
+			},
+		},
+	}
+}
+
+func resourceTagCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	owner := d.Get("owner").(string)
+	repo := d.Get("repository").(string)
+	name := d.Get("name").(string)
+
+	tag := &Ref{
+		Name:    name,
+		Message: d.Get("message").(string),
+		Target:  Commit{Hash: d.Get("target").(string)},
+	}
+
+	if err := createRef(&client, owner, repo, "tags", tag); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", owner, repo, name))
+
+	return resourceTagRead(ctx, d, m)
+}
+
+func resourceTagRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	owner, repo, name, err := refId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tag, err := readRef(&client, owner, repo, "tags", name)
+	if isNotFound(err) {
+		log.Printf("[WARN] Tag (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("owner", owner)
+	d.Set("repository", repo)
+	d.Set("name", tag.Name)
+	d.Set("target", tag.Target.Hash)
+	// Git terminates tag messages with a newline.
+	d.Set("message", strings.TrimSuffix(tag.Message, "\n"))
+	d.Set("date", tag.Date)
+
+	return nil
+}
+
+func resourceTagDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(Clients).httpClient
+
+	owner, repo, name, err := refId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.Delete(fmt.Sprintf("2.0/repositories/%s/%s/refs/tags/%s", owner, repo, url.PathEscape(name)))
+
+	return diag.FromErr(err)
+}
@@ -0,0 +1,147 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBitbucketTag_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-test")
+	owner := os.Getenv("BITBUCKET_TEAM")
+	resourceName := "bitbucket_tag.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBitbucketRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketTagConfig(owner, rName, "v1.0.0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "v1.0.0"),
+					resource.TestCheckResourceAttr(resourceName, "message", "Release v1.0.0"),
+					resource.TestCheckResourceAttrPair(resourceName, "target", "bitbucket_commit_file.test", "commit_sha"),
+					resource.TestCheckResourceAttrSet(resourceName, "date"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnitBitbucketTag_basic(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	rName := acctest.RandomWithPrefix("tf-test")
+	resourceName := "bitbucket_tag.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		CheckDestroy:      fake.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBitbucketTagConfig(fakeBitbucketTeam, rName, "v1.0.0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s/%s/v1.0.0", fakeBitbucketTeam, rName)),
+					resource.TestCheckResourceAttr(resourceName, "target", fmt.Sprintf("%040x", 1)),
+					resource.TestCheckResourceAttr(resourceName, "message", "Release v1.0.0"),
+					resource.TestCheckResourceAttrSet(resourceName, "date"),
+				),
+			},
+			{
+				// Renaming the tag replaces it.
+				Config: testAccBitbucketTagConfig(fakeBitbucketTeam, rName, "v1.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "v1.0.1"),
+					fake.CheckExists(fmt.Sprintf("repositories/%s/%s/refs/tags/v1.0.0", fakeBitbucketTeam, rName), false),
+					fake.CheckExists(fmt.Sprintf("repositories/%s/%s/refs/tags/v1.0.1", fakeBitbucketTeam, rName), true),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnitBitbucketTag_abbreviatedTarget(t *testing.T) {
+	fake := newFakeBitbucket(t)
+	rName := acctest.RandomWithPrefix("tf-test")
+	resourceName := "bitbucket_tag.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testUnitProviderFactories(fake),
+		CheckDestroy:      fake.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The full hash read back doesn't show up as a change of the abbreviated one.
+				Config: testUnitBitbucketTagAbbreviatedTargetConfig(fakeBitbucketTeam, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "target", fmt.Sprintf("%040x", 1)),
+				),
+			},
+		},
+	})
+}
+
+func testAccBitbucketTagConfig(owner, rName, name string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_commit_file" "test" {
+  filename       = "README.md"
+  content        = "abc"
+  repo_slug      = bitbucket_repository.test.name
+  workspace      = bitbucket_repository.test.owner
+  commit_author  = "Unit test <unit@test.local>"
+  branch         = "main"
+  commit_message = "Initial commit"
+}
+
+resource "bitbucket_tag" "test" {
+  owner      = bitbucket_repository.test.owner
+  repository = bitbucket_repository.test.name
+  name       = %[3]q
+  target     = bitbucket_commit_file.test.commit_sha
+  message    = "Release %[3]s"
+}
+`, owner, rName, name)
+}
+
+func testUnitBitbucketTagAbbreviatedTargetConfig(owner, rName string) string {
+	return fmt.Sprintf(`
+resource "bitbucket_repository" "test" {
+  owner = %[1]q
+  name  = %[2]q
+}
+
+resource "bitbucket_commit_file" "test" {
+  filename       = "README.md"
+  content        = "abc"
+  repo_slug      = bitbucket_repository.test.name
+  workspace      = bitbucket_repository.test.owner
+  commit_author  = "Unit test <unit@test.local>"
+  branch         = "main"
+  commit_message = "Initial commit"
+}
+
+resource "bitbucket_tag" "test" {
+  owner      = bitbucket_repository.test.owner
+  repository = bitbucket_repository.test.name
+  name       = "v1.0.0"
+  target     = substr(bitbucket_commit_file.test.commit_sha, 0, 12)
+}
+`, owner, rName)
+}
@@ -0,0 +1,129 @@
+package bitbucket
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// retryTransport is a http.RoundTripper that retries requests which were
+// rate limited (429) or, for idempotent methods, failed with a transient
+// server error (5xx).
+type retryTransport struct {
+	Transport  http.RoundTripper
+	MaxRetries int
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		r := req
+		if attempt > 0 && req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r = req.Clone(req.Context())
+			r.Body = body
+		}
+
+		resp, err := t.transport().RoundTrip(r)
+		if err != nil || !shouldRetry(req, resp) || attempt >= t.MaxRetries {
+			return resp, err
+		}
+
+		if req.Body != nil && req.GetBody == nil {
+			// The body has been consumed and can't be replayed.
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		log.Printf("[DEBUG] %s %s returned %d, retrying in %s (attempt %d/%d)",
+			req.Method, req.URL, resp.StatusCode, wait, attempt+1, t.MaxRetries)
+
+		// Drain the body so the underlying connection can be reused.
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (t *retryTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+
+	return http.DefaultTransport
+}
+
+// backoff honors the Retry-After header when set and otherwise doubles
+// MinBackoff on every attempt, up to MaxBackoff.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if v := resp.Header.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second
+		}
+
+		if date, err := http.ParseTime(v); err == nil {
+			if wait := time.Until(date); wait > 0 {
+				return wait
+			}
+			return 0
+		}
+	}
+
+	wait := t.MinBackoff << uint(attempt)
+	if wait > t.MaxBackoff || wait <= 0 {
+		wait = t.MaxBackoff
+	}
+
+	return wait
+}
+
+// shouldRetry retries rate limited requests of any method, since Bitbucket
+// rejected them before doing anything. Server errors are only retried for
+// idempotent methods, as a POST may have been applied before it failed.
+func shouldRetry(req *http.Request, resp *http.Response) bool {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+	default:
+		return false
+	}
+
+	return resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented
+}
+
+// rateLimitTransport is a http.RoundTripper that blocks until the shared
+// Limiter allows the request to be sent.
+type rateLimitTransport struct {
+	Transport http.RoundTripper
+	Limiter   *rate.Limiter
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.Limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	if t.Transport != nil {
+		return t.Transport.RoundTrip(req)
+	}
+
+	return http.DefaultTransport.RoundTrip(req)
+}
@@ -0,0 +1,176 @@
+package bitbucket
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func TestRetryTransport_retriesTransientErrors(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"name":"test"}` {
+			t.Errorf("attempt %d: unexpected body %q", attempts, string(body))
+		}
+
+		switch attempts {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HTTPClient: &http.Client{
+			Transport: &retryTransport{
+				MaxRetries: 3,
+				MinBackoff: time.Millisecond,
+				MaxBackoff: 10 * time.Millisecond,
+			},
+		},
+		BaseURL: server.URL,
+	}
+
+	res, err := client.Put("2.0/repositories/test/test", bytes.NewBufferString(`{"name":"test"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	res.Body.Close()
+
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryTransport_doesNotRetryPostServerErrors(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HTTPClient: &http.Client{
+			Transport: &retryTransport{
+				MaxRetries: 3,
+				MinBackoff: time.Millisecond,
+				MaxBackoff: time.Millisecond,
+			},
+		},
+		BaseURL: server.URL,
+	}
+
+	_, err := client.Post("2.0/repositories", bytes.NewBufferString(`{"name":"test"}`))
+	if apiErr, ok := err.(Error); !ok || apiErr.StatusCode != http.StatusBadGateway {
+		t.Fatalf("expected API error with status 502, got %#v", err)
+	}
+
+	if attempts != 1 {
+		t.Fatalf("expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestRetryTransport_givesUp(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HTTPClient: &http.Client{
+			Transport: &retryTransport{
+				MaxRetries: 2,
+				MinBackoff: time.Millisecond,
+				MaxBackoff: time.Millisecond,
+			},
+		},
+		BaseURL: server.URL,
+	}
+
+	_, err := client.Get("2.0/user")
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	if apiErr, ok := err.(Error); !ok || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected API error with status 503, got %#v", err)
+	}
+
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryTransport_backoff(t *testing.T) {
+	transport := &retryTransport{
+		MinBackoff: time.Second,
+		MaxBackoff: 5 * time.Second,
+	}
+
+	cases := []struct {
+		attempt    int
+		retryAfter string
+		expected   time.Duration
+	}{
+		{0, "", time.Second},
+		{1, "", 2 * time.Second},
+		{2, "", 4 * time.Second},
+		{3, "", 5 * time.Second},
+		{0, "10", 10 * time.Second},
+	}
+
+	for _, tc := range cases {
+		resp := &http.Response{Header: http.Header{}}
+		if tc.retryAfter != "" {
+			resp.Header.Set("Retry-After", tc.retryAfter)
+		}
+
+		if got := transport.backoff(tc.attempt, resp); got != tc.expected {
+			t.Errorf("attempt %d with Retry-After %q: expected %s, got %s", tc.attempt, tc.retryAfter, tc.expected, got)
+		}
+	}
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HTTPClient: &http.Client{
+			Transport: &rateLimitTransport{
+				Limiter: rate.NewLimiter(rate.Every(20*time.Millisecond), 1),
+			},
+		},
+		BaseURL: server.URL,
+	}
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		res, err := client.Get("2.0/user")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		res.Body.Close()
+	}
+
+	if elapsed := time.Since(start); elapsed < 60*time.Millisecond {
+		t.Fatalf("expected requests to be rate limited, 4 requests took %s", elapsed)
+	}
+}